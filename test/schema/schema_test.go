@@ -738,7 +738,7 @@ func TestSchema_integration(t *testing.T) {
 				WithValueText("Frank Herbert")).
 			WithFields(authorField, titleField).
 			Do(ctx)
-		require.NoError(t, err)
+		require.Error(t, err)
 		require.NotEmpty(t, resp.Errors)
 
 		// Verify filtering by title no longer works after index removal
@@ -750,7 +750,7 @@ func TestSchema_integration(t *testing.T) {
 				WithValueText("Dune")).
 			WithFields(authorField, titleField).
 			Do(ctx)
-		require.NoError(t, err)
+		require.Error(t, err)
 		require.NotEmpty(t, resp.Errors)
 
 		// Clean up
@@ -22,6 +22,13 @@ func (cc *AliasCreator) WithAlias(alias *Alias) *AliasCreator {
 
 // Do create a alias in the schema as specified in the builder
 func (cc *AliasCreator) Do(ctx context.Context) error {
+	ctx, op := cc.connection.Telemetry().Start(ctx, "alias.AliasCreator")
+	err := cc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (cc *AliasCreator) do(ctx context.Context) error {
 	responseData, err := cc.connection.RunREST(ctx, "/aliases", http.MethodPost, cc.alias)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
 }
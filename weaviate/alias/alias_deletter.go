@@ -23,6 +23,13 @@ func (cd *AliasDeleter) WithAliasName(alias string) *AliasDeleter {
 
 // Do delete the alias from the weaviate schema
 func (cd *AliasDeleter) Do(ctx context.Context) error {
+	ctx, op := cd.connection.Telemetry().Start(ctx, "alias.AliasDeleter")
+	err := cd.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (cd *AliasDeleter) do(ctx context.Context) error {
 	path := fmt.Sprintf("/aliases/%v", cd.alias)
 	responseData, err := cd.connection.RunREST(ctx, path, http.MethodDelete, nil)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 204)
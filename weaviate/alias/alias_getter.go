@@ -23,6 +23,13 @@ func (c *AliasGetter) WithAliasName(aliasName string) *AliasGetter {
 
 // Do get a alias as specified in the builder
 func (c *AliasGetter) Do(ctx context.Context) (*Alias, error) {
+	ctx, op := c.connection.Telemetry().Start(ctx, "alias.AliasGetter")
+	result, err := c.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (c *AliasGetter) do(ctx context.Context) (*Alias, error) {
 	responseData, err := c.connection.RunREST(ctx, fmt.Sprintf("/aliases/%s", c.alias), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
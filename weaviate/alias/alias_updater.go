@@ -23,6 +23,13 @@ func (cu *AliasUpdater) WithAlias(alias *Alias) *AliasUpdater {
 
 // Do update a alias in the schema as specified in the builder
 func (cu *AliasUpdater) Do(ctx context.Context) error {
+	ctx, op := cu.connection.Telemetry().Start(ctx, "alias.AliasUpdater")
+	err := cu.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (cu *AliasUpdater) do(ctx context.Context) error {
 	if cu.alias == nil {
 		return except.NewWeaviateClientError(0, "an alias must be provided")
 	}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // Getter builder object to get a list of aliases
@@ -24,6 +25,13 @@ func (s *Getter) WithClassName(className string) *Getter {
 
 // Do get the list of alias
 func (s *Getter) Do(ctx context.Context) ([]Alias, error) {
+	ctx, op := s.connection.Telemetry().Start(ctx, "alias.Getter", telemetry.Collection(s.className))
+	result, err := s.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (s *Getter) do(ctx context.Context) ([]Alias, error) {
 	return listAlias(ctx, s.connection, s.className)
 }
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net/http"
 	"strings"
 	"time"
@@ -42,7 +41,7 @@ func (ab *authBase) getIdAndTokenEndpoint(ctx context.Context, con *connection.C
 
 	switch status := rest.StatusCode; status {
 	case 404:
-		log.Println("Auth001: The client was configured to use authentication, but weaviate is configured without" +
+		con.Logger().Warn("Auth001: The client was configured to use authentication, but weaviate is configured without " +
 			"authentication. Are you sure this is correct?")
 		return nil
 	case 200: // status code is ok
@@ -50,10 +49,10 @@ func (ab *authBase) getIdAndTokenEndpoint(ctx context.Context, con *connection.C
 		if decodeErr != nil {
 			// Some setups are behind proxies that return some default page - for example a login - for all requests.
 			// If the response is not json, we assume that this is the case and try unauthenticated access.
-			log.Printf("Auth005: Could not parse Weaviates OIDC configuration, using unauthenticated access. If "+
+			con.Logger().Warn("Auth005: Could not parse Weaviates OIDC configuration, using unauthenticated access. If "+
 				"you added an authorization header yourself it will be unaffected. This can happen if weaviate is "+
-				"miss-configured or you have a proxy in between the client and weaviate. You can test this by visiting %v.",
-				oidcConfigURL)
+				"miss-configured or you have a proxy in between the client and weaviate. You can test this by visiting the url.",
+				"url", oidcConfigURL)
 
 			return nil
 		}
@@ -143,7 +142,8 @@ func (ro ResourceOwnerPasswordFlow) GetAuthInfo(con *connection.Connection) (*ht
 	// username + password are not saved by the client, so there is no possibility of refreshing the token with a
 	// refresh_token.
 	if token.RefreshToken == "" {
-		log.Printf("Auth002: Your access token is valid for %v and no refresh token was provided.", time.Until(token.Expiry))
+		con.Logger().Warn("Auth002: Your access token is valid for a limited time and no refresh token was provided.",
+			"valid_for", time.Until(token.Expiry))
 		return oauth2.NewClient(context.TODO(), oauth2.StaticTokenSource(token)), nil, nil
 	}
 
@@ -177,7 +177,8 @@ func (bt BearerToken) GetAuthInfo(con *connection.Connection) (*http.Client, map
 
 	// there is no possibility of refreshing the token without a refresh_token.
 	if bt.RefreshToken == "" {
-		log.Printf("Auth002: Your access token is valid for %v and no refresh token was provided.", time.Now().Add(time.Second*time.Duration(bt.ExpiresIn)))
+		con.Logger().Warn("Auth002: Your access token is valid for a limited time and no refresh token was provided.",
+			"valid_until", time.Now().Add(time.Second*time.Duration(bt.ExpiresIn)))
 		return oauth2.NewClient(context.TODO(), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: bt.AccessToken})), nil, nil
 	}
 	conf := oauth2.Config{ClientID: bt.ClientId, Endpoint: oauth2.Endpoint{TokenURL: bt.TokenEndpoint}}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 type BackupCanceler struct {
@@ -26,6 +27,13 @@ func (bc *BackupCanceler) WithBackupID(id string) *BackupCanceler {
 }
 
 func (bc *BackupCanceler) Do(ctx context.Context) error {
+	ctx, op := bc.connection.Telemetry().Start(ctx, "backup.BackupCanceler", telemetry.Backend(bc.backend))
+	err := bc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (bc *BackupCanceler) do(ctx context.Context) error {
 	res, err := bc.connection.RunREST(ctx, bc.path(), http.MethodDelete, nil)
 	if err != nil {
 		return except.NewDerivedWeaviateClientError(err)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -29,6 +30,14 @@ func (g *BackupCreateStatusGetter) WithBackupID(backupID string) *BackupCreateSt
 }
 
 func (g *BackupCreateStatusGetter) Do(ctx context.Context) (*models.BackupCreateStatusResponse, error) {
+	ctx, op := g.connection.Telemetry().Start(ctx, "backup.BackupCreateStatusGetter",
+		telemetry.Backend(g.backend))
+	result, err := g.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (g *BackupCreateStatusGetter) do(ctx context.Context) (*models.BackupCreateStatusResponse, error) {
 	response, err := g.connection.RunREST(ctx, g.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -65,6 +66,14 @@ func (c *BackupCreator) WithConfig(cfg *models.BackupConfig) *BackupCreator {
 }
 
 func (c *BackupCreator) Do(ctx context.Context) (*models.BackupCreateResponse, error) {
+	ctx, op := c.connection.Telemetry().Start(ctx, "backup.BackupCreator",
+		telemetry.Backend(c.backend))
+	result, err := c.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (c *BackupCreator) do(ctx context.Context) (*models.BackupCreateResponse, error) {
 	payload := models.BackupCreateRequest{
 		ID:      c.backupID,
 		Include: c.includeClasses,
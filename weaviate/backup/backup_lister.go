@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -27,6 +28,13 @@ func (bc *BackupLister) WithStartedAtAsc(startedAtAsc bool) *BackupLister {
 }
 
 func (bc *BackupLister) Do(ctx context.Context) (models.BackupListResponse, error) {
+	ctx, op := bc.connection.Telemetry().Start(ctx, "backup.BackupLister", telemetry.Backend(bc.backend))
+	result, err := bc.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (bc *BackupLister) do(ctx context.Context) (models.BackupListResponse, error) {
 	response, err := bc.connection.RunREST(ctx, bc.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 type BackupRestoreCanceler struct {
@@ -44,6 +45,14 @@ func (rc *BackupRestoreCanceler) WithPath(path string) *BackupRestoreCanceler {
 
 // Do cancels an ongoing backup restore operation
 func (rc *BackupRestoreCanceler) Do(ctx context.Context) error {
+	ctx, op := rc.connection.Telemetry().Start(ctx, "backup.BackupRestoreCanceler",
+		telemetry.Backend(rc.backend))
+	err := rc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (rc *BackupRestoreCanceler) do(ctx context.Context) error {
 	res, err := rc.connection.RunREST(ctx, rc.path(), http.MethodDelete, nil)
 	if err != nil {
 		return except.NewDerivedWeaviateClientError(err)
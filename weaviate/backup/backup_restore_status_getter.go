@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -29,6 +30,14 @@ func (g *BackupRestoreStatusGetter) WithBackupID(backupID string) *BackupRestore
 }
 
 func (g *BackupRestoreStatusGetter) Do(ctx context.Context) (*models.BackupRestoreStatusResponse, error) {
+	ctx, op := g.connection.Telemetry().Start(ctx, "backup.BackupRestoreStatusGetter",
+		telemetry.Backend(g.backend))
+	result, err := g.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (g *BackupRestoreStatusGetter) do(ctx context.Context) (*models.BackupRestoreStatusResponse, error) {
 	response, err := g.connection.RunREST(ctx, g.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
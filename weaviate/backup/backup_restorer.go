@@ -9,6 +9,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/backup/rbac"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -100,6 +101,14 @@ func (r *BackupRestorer) WithoutRBAC() *BackupRestorer {
 }
 
 func (r *BackupRestorer) Do(ctx context.Context) (*models.BackupRestoreResponse, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "backup.BackupRestorer",
+		telemetry.Backend(r.backend))
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *BackupRestorer) do(ctx context.Context) (*models.BackupRestoreResponse, error) {
 	payload := models.BackupRestoreRequest{
 		Include:        r.includeClasses,
 		Exclude:        r.excludeClasses,
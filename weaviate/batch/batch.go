@@ -26,10 +26,19 @@ func (batch *API) ObjectsBatcher() *ObjectsBatcher {
 	}
 }
 
+// StreamBatcher returns a builder which opens a stream sending objects and references
+// to Weaviate as they are produced. It requires a gRPC connection.
+func (batch *API) StreamBatcher() *StreamBatcher {
+	return &StreamBatcher{
+		grpcClient: batch.grpcClient,
+	}
+}
+
 // ObjectsBatchDeleter returns a builder which deletes objects in bulk
 func (batch *API) ObjectsBatchDeleter() *ObjectsBatchDeleter {
 	return &ObjectsBatchDeleter{
 		connection: batch.connection,
+		grpcClient: batch.grpcClient,
 	}
 }
 
@@ -45,6 +54,7 @@ func (batch *API) ReferencePayloadBuilder() *ReferencePayloadBuilder {
 func (batch *API) ReferencesBatcher() *ReferencesBatcher {
 	return &ReferencesBatcher{
 		connection: batch.connection,
+		grpcClient: batch.grpcClient,
 		references: []*models.BatchReference{},
 	}
 }
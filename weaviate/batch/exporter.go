@@ -0,0 +1,266 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"maps"
+	"slices"
+	"unicode"
+
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/data"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/graphql"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const defaultExportPageSize = 100
+
+// ExportLine is one line of an export. The first line holds the class,
+// every other line one object.
+type ExportLine struct {
+	Class  *models.Class  `json:"class,omitempty"`
+	Object *models.Object `json:"object,omitempty"`
+}
+
+// ExportResult of an Exporter
+type ExportResult struct {
+	// Objects is the number of exported objects
+	Objects int
+	// Tenants whose objects have been exported
+	Tenants []string
+	// SkippedTenants are not active, their objects have not been exported
+	SkippedTenants []string
+}
+
+// Exporter builder to write every object of a class as JSONL, see ExportLine.
+// The output can be read with ExportReader and imported into another cluster.
+type Exporter struct {
+	api              *API
+	className        string
+	tenants          []string
+	withVector       bool
+	pageSize         int
+	consistencyLevel string
+	writer           io.Writer
+}
+
+// Exporter returns a builder which exports all objects of a class
+func (batch *API) Exporter() *Exporter {
+	return &Exporter{
+		api:      batch,
+		pageSize: defaultExportPageSize,
+	}
+}
+
+// WithClassName of the class to export
+func (e *Exporter) WithClassName(className string) *Exporter {
+	e.className = className
+	return e
+}
+
+// WithTenants exports only the objects of the given tenants. By default all active
+// tenants of a multi-tenant class are exported.
+func (e *Exporter) WithTenants(tenants ...string) *Exporter {
+	e.tenants = tenants
+	return e
+}
+
+// WithVector includes the vector and the named vectors of the objects
+func (e *Exporter) WithVector() *Exporter {
+	e.withVector = true
+	return e
+}
+
+// WithPageSize sets the number of objects fetched in one request, 100 by default
+func (e *Exporter) WithPageSize(pageSize int) *Exporter {
+	if pageSize > 0 {
+		e.pageSize = pageSize
+	}
+	return e
+}
+
+// WithConsistencyLevel determines how many replicas must acknowledge a request
+// before it is considered successful. Can be one of 'ALL', 'ONE', or 'QUORUM'.
+func (e *Exporter) WithConsistencyLevel(cl string) *Exporter {
+	e.consistencyLevel = cl
+	return e
+}
+
+// WithWriter the export is written to
+func (e *Exporter) WithWriter(w io.Writer) *Exporter {
+	e.writer = w
+	return e
+}
+
+// Do writes the class and then walks all objects with the after cursor, using gRPC if
+// available. Classes with references or blobs are read over REST, as gRPC does not return
+// the beacons of references, nor blobs with the other properties. A multi-tenant class
+// without active tenants results in an export without objects.
+func (e *Exporter) Do(ctx context.Context) (*ExportResult, error) {
+	if e.writer == nil {
+		return nil, errors.New("exporter: no writer set")
+	}
+	schemaAPI := schema.New(e.api.connection, nil)
+	class, err := schemaAPI.ClassGetter().WithClassName(e.className).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("exporter: get class %s: %w", e.className, err)
+	}
+	result := &ExportResult{Tenants: e.tenants}
+	if len(e.tenants) == 0 && isMultiTenant(class) {
+		tenants, err := schemaAPI.TenantsGetter().WithClassName(class.Class).Do(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("exporter: get tenants of %s: %w", class.Class, err)
+		}
+		for _, tenant := range tenants {
+			if isActive(tenant.ActivityStatus) {
+				result.Tenants = append(result.Tenants, tenant.Name)
+			} else {
+				result.SkippedTenants = append(result.SkippedTenants, tenant.Name)
+			}
+		}
+	}
+
+	encoder := json.NewEncoder(e.writer)
+	if err := encoder.Encode(ExportLine{Class: class}); err != nil {
+		return nil, fmt.Errorf("exporter: write class: %w", err)
+	}
+	all := e.restObjects
+	if e.api.grpcClient != nil && !hasReferences(class) && !hasBlobs(class) {
+		all = e.grpcObjects
+	}
+	tenants := result.Tenants
+	if len(tenants) == 0 && !isMultiTenant(class) {
+		tenants = []string{""}
+	}
+	for _, tenant := range tenants {
+		for object, err := range all(ctx, class, tenant) {
+			if err != nil {
+				return result, fmt.Errorf("exporter: read objects of %s: %w", class.Class, err)
+			}
+			object.Tenant = tenant
+			if err := encoder.Encode(ExportLine{Object: object}); err != nil {
+				return result, fmt.Errorf("exporter: write object %s: %w", object.ID, err)
+			}
+			result.Objects++
+		}
+	}
+	return result, nil
+}
+
+func (e *Exporter) restObjects(ctx context.Context, class *models.Class, tenant string) iter.Seq2[*models.Object, error] {
+	getter := data.New(e.api.connection, e.api.dbVersionSupport).ObjectsGetter().
+		WithClassName(class.Class).
+		WithTenant(tenant).
+		WithConsistencyLevel(e.consistencyLevel).
+		WithLimit(e.pageSize)
+	if e.withVector {
+		getter.WithVector()
+	}
+	return getter.All(ctx)
+}
+
+func (e *Exporter) grpcObjects(ctx context.Context, class *models.Class, tenant string) iter.Seq2[*models.Object, error] {
+	metadata := &graphql.Metadata{ID: true, CreationTimeUnix: true, LastUpdateTimeUnix: true}
+	if e.withVector {
+		metadata.Vector = len(class.VectorConfig) == 0
+		metadata.Vectors = slices.Sorted(maps.Keys(class.VectorConfig))
+	}
+	search := graphql.NewSearch(e.api.grpcClient).
+		WithCollection(class.Class).
+		WithTenant(tenant).
+		WithConsistencyLevel(e.consistencyLevel).
+		WithLimit(e.pageSize).
+		WithMetadata(metadata)
+	return func(yield func(*models.Object, error) bool) {
+		for result, err := range search.All(ctx) {
+			var object *models.Object
+			if err == nil {
+				object = result.Object()
+				object.Class = class.Class
+			}
+			if !yield(object, err) {
+				return
+			}
+		}
+	}
+}
+
+// hasReferences reports whether a class has reference properties, whose data types are class names
+func hasReferences(class *models.Class) bool {
+	for _, prop := range class.Properties {
+		for _, dataType := range prop.DataType {
+			if dataType != "" && unicode.IsUpper([]rune(dataType)[0]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasBlobs reports whether a class has blob properties, which are not returned with
+// all other properties over gRPC
+func hasBlobs(class *models.Class) bool {
+	for _, prop := range class.Properties {
+		if slices.Contains(prop.DataType, "blob") {
+			return true
+		}
+	}
+	return false
+}
+
+func isMultiTenant(class *models.Class) bool {
+	return class.MultiTenancyConfig != nil && class.MultiTenancyConfig.Enabled
+}
+
+func isActive(activityStatus string) bool {
+	switch activityStatus {
+	case "", models.TenantActivityStatusHOT, models.TenantActivityStatusACTIVE:
+		return true
+	default:
+		return false
+	}
+}
+
+// ExportReader reads an export written by an Exporter
+type ExportReader struct {
+	decoder *json.Decoder
+	class   *models.Class
+}
+
+// NewExportReader reads the class of an export. Numbers are read as json.Number,
+// so that integers beyond the precision of a float64 are imported unchanged.
+func NewExportReader(r io.Reader) (*ExportReader, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var line ExportLine
+	if err := decoder.Decode(&line); err != nil {
+		return nil, fmt.Errorf("read export class: %w", err)
+	}
+	if line.Class == nil {
+		return nil, errors.New("read export class: first line holds no class")
+	}
+	return &ExportReader{decoder: decoder, class: line.Class}, nil
+}
+
+// Class of the exported objects, to create it with schema.ClassCreator
+func (r *ExportReader) Class() *models.Class {
+	return r.class
+}
+
+// Next returns the next object, and io.EOF after the last one. The objects
+// can be imported with ObjectsBatcher or Manager.
+func (r *ExportReader) Next() (*models.Object, error) {
+	for {
+		var line ExportLine
+		if err := r.decoder.Decode(&line); err != nil {
+			return nil, err
+		}
+		if line.Object != nil {
+			return line.Object, nil
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package batch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+var exportIDs = []string{
+	"00000000-0000-0000-0000-000000000001",
+	"00000000-0000-0000-0000-000000000002",
+	"00000000-0000-0000-0000-000000000003",
+}
+
+// page returns the IDs following after
+func page(after string, limit int) []string {
+	start := 0
+	if after != "" {
+		start = slices.Index(exportIDs, after) + 1
+	}
+	return exportIDs[start:min(start+limit, len(exportIDs))]
+}
+
+// newExportServer serves the multi-tenant class "Article" with the tenants "a" and "b",
+// of which "b" is inactive, and the objects of tenant "a".
+func newExportServer(t *testing.T) *connection.Connection {
+	return newExportServerWith(t,
+		[]*models.Property{{Name: "title", DataType: []string{"text"}}},
+		[]models.Tenant{
+			{Name: "a", ActivityStatus: models.TenantActivityStatusHOT},
+			{Name: "b", ActivityStatus: models.TenantActivityStatusCOLD},
+		})
+}
+
+// newExportServerWith serves the multi-tenant class "Article" with the given properties
+// and tenants, and the objects of tenant "a".
+func newExportServerWith(t *testing.T, properties []*models.Property, tenants []models.Tenant) *connection.Connection {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/schema/Article":
+			json.NewEncoder(w).Encode(models.Class{
+				Class:              "Article",
+				Properties:         properties,
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+			})
+		case "/v1/schema/Article/tenants":
+			json.NewEncoder(w).Encode(tenants)
+		case "/v1/objects":
+			query := r.URL.Query()
+			if query.Get("class") != "Article" || query.Get("tenant") != "a" || query.Get("include") != "vector" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			limit, _ := strconv.Atoi(query.Get("limit"))
+			var objects []*models.Object
+			for _, id := range page(query.Get("after"), limit) {
+				objects = append(objects, &models.Object{
+					Class: "Article", ID: strfmt.UUID(id),
+					Properties: map[string]any{"title": id},
+					Vector:     models.C11yVector{1, 2},
+				})
+			}
+			json.NewEncoder(w).Encode(models.ObjectsListResponse{Objects: objects})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+}
+
+func newTestDBVersionSupport() *db.VersionSupport {
+	return db.NewDBVersionSupport(db.NewVersionProvider(func() string { return "1.30.0" }))
+}
+
+func TestExporter_REST(t *testing.T) {
+	var out bytes.Buffer
+	result, err := New(newExportServer(t), nil, newTestDBVersionSupport()).Exporter().
+		WithClassName("Article").
+		WithVector().
+		WithPageSize(2).
+		WithWriter(&out).
+		Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Objects)
+	assert.Equal(t, []string{"a"}, result.Tenants)
+	assert.Equal(t, []string{"b"}, result.SkippedTenants)
+
+	reader, err := NewExportReader(&out)
+	require.NoError(t, err)
+	assert.Equal(t, "Article", reader.Class().Class)
+	var objects []*models.Object
+	for {
+		object, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		objects = append(objects, object)
+	}
+	require.Len(t, objects, 3)
+	for i, object := range objects {
+		assert.Equal(t, exportIDs[i], object.ID.String())
+		assert.Equal(t, "a", object.Tenant)
+		assert.Equal(t, models.C11yVector{1, 2}, object.Vector)
+		assert.Equal(t, map[string]any{"title": exportIDs[i]}, object.Properties)
+	}
+}
+
+// fakeSearchServer pages through exportIDs
+type fakeSearchServer struct {
+	pb.UnimplementedWeaviateServer
+	requests []*pb.SearchRequest
+}
+
+func (f *fakeSearchServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	f.requests = append(f.requests, req)
+	reply := &pb.SearchReply{}
+	for _, id := range page(req.After, int(req.Limit)) {
+		reply.Results = append(reply.Results, &pb.SearchResult{
+			Metadata: &pb.MetadataResult{Id: id, VectorBytes: byteops.Fp32SliceToBytes([]float32{1, 2})},
+			Properties: &pb.PropertiesResult{NonRefProps: &pb.Properties{Fields: map[string]*pb.Value{
+				"title": {Kind: &pb.Value_TextValue{TextValue: id}},
+				"tags": {Kind: &pb.Value_ListValue{ListValue: &pb.ListValue{
+					Kind: &pb.ListValue_TextValues{TextValues: &pb.TextValues{Values: []string{"x"}}},
+				}}},
+			}}},
+		})
+	}
+	return reply, nil
+}
+
+func TestExporter_GRPC(t *testing.T) {
+	server := &fakeSearchServer{}
+	var out bytes.Buffer
+	result, err := New(newExportServer(t), newTestGrpcClient(t, server), nil).Exporter().
+		WithClassName("Article").
+		WithTenants("a").
+		WithVector().
+		WithPageSize(3).
+		WithWriter(&out).
+		Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Objects)
+	assert.Empty(t, result.SkippedTenants)
+
+	require.Len(t, server.requests, 2)
+	assert.Equal(t, "", server.requests[0].After)
+	assert.Equal(t, exportIDs[2], server.requests[1].After)
+	assert.Equal(t, "a", server.requests[1].Tenant)
+	assert.True(t, server.requests[0].Metadata.Vector)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 4)
+	var line ExportLine
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &line))
+	assert.Equal(t, exportIDs[0], line.Object.ID.String())
+	assert.Equal(t, "Article", line.Object.Class)
+	assert.Equal(t, map[string]any{"title": exportIDs[0], "tags": []any{"x"}}, line.Object.Properties)
+	assert.Equal(t, models.C11yVector{1, 2}, line.Object.Vector)
+}
+
+func TestExporter_Blobs(t *testing.T) {
+	server := &fakeSearchServer{}
+	con := newExportServerWith(t, []*models.Property{
+		{Name: "title", DataType: []string{"text"}},
+		{Name: "image", DataType: []string{"blob"}},
+	}, nil)
+	var out bytes.Buffer
+	result, err := New(con, newTestGrpcClient(t, server), newTestDBVersionSupport()).Exporter().
+		WithClassName("Article").
+		WithTenants("a").
+		WithVector().
+		WithWriter(&out).
+		Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Objects)
+	assert.Empty(t, server.requests, "blobs are read over REST")
+}
+
+func TestExporter_NoActiveTenants(t *testing.T) {
+	con := newExportServerWith(t, []*models.Property{{Name: "title", DataType: []string{"text"}}},
+		[]models.Tenant{{Name: "b", ActivityStatus: models.TenantActivityStatusCOLD}})
+	var out bytes.Buffer
+	result, err := New(con, nil, newTestDBVersionSupport()).Exporter().
+		WithClassName("Article").
+		WithWriter(&out).
+		Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Objects)
+	assert.Empty(t, result.Tenants)
+	assert.Equal(t, []string{"b"}, result.SkippedTenants)
+
+	reader, err := NewExportReader(&out)
+	require.NoError(t, err)
+	assert.Equal(t, "Article", reader.Class().Class)
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestExportReader_KeepsNumbers(t *testing.T) {
+	export := `{"class": {"class": "Article"}}
+{"object": {"class": "Article", "properties": {"views": 9007199254740993, "score": 0.5}}}
+`
+	reader, err := NewExportReader(strings.NewReader(export))
+	require.NoError(t, err)
+	object, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"views": json.Number("9007199254740993"), "score": json.Number("0.5")}, object.Properties)
+}
@@ -0,0 +1,181 @@
+package batch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/fault"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeGrpcBatchServer fails the second reference and deletes two objects, one of them failing
+type fakeGrpcBatchServer struct {
+	pb.UnimplementedWeaviateServer
+	references  []*pb.BatchReference
+	deleteQuery *pb.BatchDeleteRequest
+}
+
+func (f *fakeGrpcBatchServer) BatchReferences(ctx context.Context, req *pb.BatchReferencesRequest) (*pb.BatchReferencesReply, error) {
+	f.references = req.References
+	return &pb.BatchReferencesReply{Errors: []*pb.BatchReferencesReply_BatchError{{Index: 1, Error: "target not found"}}}, nil
+}
+
+func (f *fakeGrpcBatchServer) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+	f.deleteQuery = req
+	failed := "locked"
+	first, second := uuid.MustParse("00000000-0000-0000-0000-000000000001"), uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	return &pb.BatchDeleteReply{
+		Matches: 2, Successful: 1, Failed: 1,
+		Objects: []*pb.BatchDeleteObject{
+			{Uuid: first[:], Successful: true},
+			{Uuid: second[:], Error: &failed},
+		},
+	}, nil
+}
+
+// unavailableGrpcBatchServer fails every batch request
+type unavailableGrpcBatchServer struct {
+	pb.UnimplementedWeaviateServer
+}
+
+func (unavailableGrpcBatchServer) BatchReferences(context.Context, *pb.BatchReferencesRequest) (*pb.BatchReferencesReply, error) {
+	return nil, status.Error(codes.Unavailable, "shutting down")
+}
+
+func (unavailableGrpcBatchServer) BatchDelete(context.Context, *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+	return nil, status.Error(codes.Unavailable, "shutting down")
+}
+
+// newRESTCounter returns a connection which counts REST requests and answers them with 500
+func newRESTCounter(t *testing.T, calls *atomic.Int32) *connection.Connection {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+	return connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+}
+
+func TestReferencesBatcher_GRPC(t *testing.T) {
+	server := &fakeGrpcBatchServer{}
+	var restCalls atomic.Int32
+	api := New(newRESTCounter(t, &restCalls), newTestGrpcClient(t, server), nil)
+
+	res, err := api.ReferencesBatcher().WithReferences(
+		&models.BatchReference{
+			From: "weaviate://localhost/Article/00000000-0000-0000-0000-000000000001/hasAuthor",
+			To:   "weaviate://localhost/Author/00000000-0000-0000-0000-000000000002",
+		},
+		&models.BatchReference{
+			From:   "weaviate://localhost/Article/00000000-0000-0000-0000-000000000001/hasAuthor",
+			To:     "weaviate://localhost/00000000-0000-0000-0000-000000000003",
+			Tenant: "tenant",
+		},
+	).Do(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, restCalls.Load())
+
+	require.Len(t, server.references, 2)
+	assert.Equal(t, "Article", server.references[0].FromCollection)
+	assert.Equal(t, "hasAuthor", server.references[0].Name)
+	assert.Equal(t, "Author", server.references[0].GetToCollection())
+	assert.Nil(t, server.references[1].ToCollection)
+	assert.Equal(t, "tenant", server.references[1].Tenant)
+
+	require.Len(t, res, 2)
+	assert.Equal(t, models.BatchReferenceResponseAO1ResultStatusSUCCESS, *res[0].Result.Status)
+	assert.Nil(t, res[0].Result.Errors)
+	assert.Equal(t, models.BatchReferenceResponseAO1ResultStatusFAILED, *res[1].Result.Status)
+	assert.Equal(t, "target not found", res[1].Result.Errors.Error[0].Message)
+
+	t.Run("short-form sources are sent over REST", func(t *testing.T) {
+		_, err := api.ReferencesBatcher().WithReferences(&models.BatchReference{
+			From: "weaviate://localhost/00000000-0000-0000-0000-000000000001/hasAuthor",
+			To:   "weaviate://localhost/00000000-0000-0000-0000-000000000002",
+		}).Do(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), restCalls.Load())
+	})
+}
+
+func TestObjectsBatchDeleter_GRPC(t *testing.T) {
+	server := &fakeGrpcBatchServer{}
+	var restCalls atomic.Int32
+	api := New(newRESTCounter(t, &restCalls), newTestGrpcClient(t, server), nil)
+
+	res, err := api.ObjectsBatchDeleter().
+		WithClassName("Article").
+		WithWhere(filters.Where().WithPath([]string{"title"}).WithOperator(filters.Equal).WithValueText("old")).
+		WithOutput("verbose").
+		WithTenant("tenant").
+		WithConsistencyLevel("QUORUM").
+		Do(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, restCalls.Load())
+
+	require.NotNil(t, server.deleteQuery)
+	assert.Equal(t, "Article", server.deleteQuery.Collection)
+	assert.True(t, server.deleteQuery.Verbose)
+	assert.False(t, server.deleteQuery.DryRun)
+	assert.Equal(t, "tenant", server.deleteQuery.GetTenant())
+	assert.Equal(t, pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM, server.deleteQuery.GetConsistencyLevel())
+	assert.NotNil(t, server.deleteQuery.Filters)
+
+	assert.Equal(t, "Article", res.Match.Class)
+	assert.Equal(t, "verbose", *res.Output)
+	assert.Equal(t, int64(2), res.Results.Matches)
+	assert.Equal(t, int64(1), res.Results.Successful)
+	assert.Equal(t, int64(1), res.Results.Failed)
+	require.Len(t, res.Results.Objects, 2)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", res.Results.Objects[0].ID.String())
+	assert.Equal(t, models.BatchDeleteResponseResultsObjectsItems0StatusSUCCESS, *res.Results.Objects[0].Status)
+	assert.Equal(t, models.BatchDeleteResponseResultsObjectsItems0StatusFAILED, *res.Results.Objects[1].Status)
+	assert.Equal(t, "locked", res.Results.Objects[1].Errors.Error[0].Message)
+}
+
+func TestGRPC_FallsBackToREST(t *testing.T) {
+	var restCalls atomic.Int32
+	api := New(newRESTCounter(t, &restCalls), newTestGrpcClient(t, &fakeBatchServer{}), nil)
+
+	_, err := api.ObjectsBatchDeleter().
+		WithClassName("Article").
+		WithWhere(filters.Where().WithPath([]string{"title"}).WithOperator(filters.Equal).WithValueText("old")).
+		Do(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), restCalls.Load())
+}
+
+func TestGRPC_Errors(t *testing.T) {
+	var restCalls atomic.Int32
+	api := New(newRESTCounter(t, &restCalls), newTestGrpcClient(t, unavailableGrpcBatchServer{}), nil)
+
+	_, err := api.ReferencesBatcher().WithReferences(&models.BatchReference{
+		From: "weaviate://localhost/Article/00000000-0000-0000-0000-000000000001/hasAuthor",
+		To:   "weaviate://localhost/Author/00000000-0000-0000-0000-000000000002",
+	}).Do(context.Background())
+	var clientErr *fault.WeaviateClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, codes.Unavailable, status.Code(clientErr.DerivedFromError))
+
+	_, err = api.ObjectsBatchDeleter().
+		WithClassName("Article").
+		WithWhere(filters.Where().WithPath([]string{"title"}).WithOperator(filters.Equal).WithValueText("old")).
+		Do(context.Background())
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, codes.Unavailable, status.Code(clientErr.DerivedFromError))
+	assert.Zero(t, restCalls.Load())
+}
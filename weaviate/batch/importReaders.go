@@ -0,0 +1,342 @@
+package batch
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Format of the rows read by an Importer
+type Format string
+
+const (
+	// FormatJSONL reads one JSON object per line
+	FormatJSONL Format = "jsonl"
+	// FormatCSV reads comma separated values with a header row naming the columns
+	FormatCSV Format = "csv"
+	// FormatParquet reads the rows of a Parquet file
+	FormatParquet Format = "parquet"
+)
+
+// rowReader returns the rows of a source as column name to value, and io.EOF after the last row
+type rowReader interface {
+	next() (map[string]any, error)
+}
+
+func newRowReader(format Format, r io.Reader, comma rune) (rowReader, error) {
+	switch format {
+	case FormatJSONL:
+		decoder := json.NewDecoder(r)
+		decoder.UseNumber()
+		return &jsonlReader{decoder: decoder}, nil
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		if comma != 0 {
+			reader.Comma = comma
+		}
+		reader.ReuseRecord = true
+		header, err := reader.Read()
+		if err != nil {
+			return nil, fmt.Errorf("read csv header: %w", err)
+		}
+		return &csvReader{reader: reader, header: append([]string{}, header...)}, nil
+	case FormatParquet:
+		return newParquetReader(r)
+	default:
+		return nil, fmt.Errorf("unsupported import format %q", format)
+	}
+}
+
+type jsonlReader struct {
+	decoder *json.Decoder
+}
+
+func (r *jsonlReader) next() (map[string]any, error) {
+	var row map[string]any
+	if err := r.decoder.Decode(&row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+type csvReader struct {
+	reader *csv.Reader
+	header []string
+}
+
+func (r *csvReader) next() (map[string]any, error) {
+	record, err := r.reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	row := make(map[string]any, len(record))
+	for i, value := range record {
+		// empty cells are missing values
+		if i < len(r.header) && value != "" {
+			row[r.header[i]] = value
+		}
+	}
+	return row, nil
+}
+
+type parquetReader struct {
+	reader *parquet.Reader
+}
+
+// newParquetReader opens a Parquet file. Files need random access, so sources which
+// are not an io.ReaderAt with a known size are read into memory first.
+func newParquetReader(r io.Reader) (rowReader, error) {
+	readerAt, ok := r.(io.ReaderAt)
+	size, sized := parquetSize(r)
+	if !ok || !sized {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		readerAt, size = bytes.NewReader(data), int64(len(data))
+	}
+	file, err := parquet.OpenFile(readerAt, size)
+	if err != nil {
+		return nil, fmt.Errorf("open parquet file: %w", err)
+	}
+	return &parquetReader{reader: parquet.NewReader(file)}, nil
+}
+
+func parquetSize(r io.Reader) (int64, bool) {
+	switch f := r.(type) {
+	case interface{ Size() int64 }:
+		return f.Size(), true
+	case io.Seeker:
+		end, err := f.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, false
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return 0, false
+		}
+		return end, true
+	default:
+		return 0, false
+	}
+}
+
+func (r *parquetReader) next() (map[string]any, error) {
+	row := map[string]any{}
+	if err := r.reader.Read(&row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// coerce converts the value of a column to the data type of a property
+func coerce(value any, dataType []string) (any, error) {
+	if len(dataType) == 0 {
+		return normalize(value)
+	}
+	switch dt := dataType[0]; dt {
+	case "text", "string", "uuid", "blob":
+		return coerceString(value)
+	case "int":
+		return coerceInt(value)
+	case "number":
+		return coerceNumber(value)
+	case "boolean":
+		return coerceBool(value)
+	case "date":
+		return coerceDate(value)
+	case "text[]", "string[]", "uuid[]", "int[]", "number[]", "boolean[]", "date[]":
+		items, err := coerceList(value)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]any, len(items))
+		for i, item := range items {
+			if result[i], err = coerce(item, []string{strings.TrimSuffix(dt, "[]")}); err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		return result, nil
+	default:
+		// objects, geo coordinates, phone numbers and references are JSON in text sources
+		if s, ok := value.(string); ok {
+			var decoded any
+			if err := json.Unmarshal([]byte(s), &decoded); err != nil {
+				return nil, fmt.Errorf("parse %s: %w", dt, err)
+			}
+			return decoded, nil
+		}
+		return normalize(value)
+	}
+}
+
+// normalize converts values which cannot be encoded as JSON
+func normalize(value any) (any, error) {
+	switch v := value.(type) {
+	case []byte:
+		return string(v), nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	default:
+		return value, nil
+	}
+}
+
+func coerceString(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case [16]byte:
+		// Parquet UUID columns
+		return fmt.Sprintf("%x-%x-%x-%x-%x", v[0:4], v[4:6], v[6:8], v[8:10], v[10:16]), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+func coerceInt(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return floatToInt(f)
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case uint32:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	case float32:
+		return floatToInt(float64(v))
+	case float64:
+		return floatToInt(v)
+	default:
+		return nil, fmt.Errorf("cannot convert %T to int", value)
+	}
+}
+
+func floatToInt(f float64) (any, error) {
+	if f != float64(int64(f)) {
+		return nil, fmt.Errorf("%v is not an integer", f)
+	}
+	return int64(f), nil
+}
+
+func coerceNumber(value any) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(v), 64)
+	case json.Number:
+		return v.Float64()
+	case int:
+		return float64(v), nil
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint:
+		return float64(v), nil
+	case uint32:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to number", value)
+	}
+}
+
+func coerceBool(value any) (any, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(strings.TrimSpace(v))
+	default:
+		return nil, fmt.Errorf("cannot convert %T to boolean", value)
+	}
+}
+
+func coerceDate(value any) (any, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case string:
+		for _, layout := range []string{time.RFC3339Nano, time.DateTime, time.DateOnly} {
+			if t, err := time.Parse(layout, strings.TrimSpace(v)); err == nil {
+				return t.Format(time.RFC3339Nano), nil
+			}
+		}
+		return nil, fmt.Errorf("cannot parse date %q", v)
+	default:
+		return nil, fmt.Errorf("cannot convert %T to date", value)
+	}
+}
+
+// coerceList returns the items of an array value, or of a JSON array in a text source
+func coerceList(value any) ([]any, error) {
+	switch v := value.(type) {
+	case []any:
+		return v, nil
+	case string:
+		decoder := json.NewDecoder(strings.NewReader(v))
+		decoder.UseNumber()
+		var items []any
+		if err := decoder.Decode(&items); err != nil {
+			return nil, fmt.Errorf("parse array: %w", err)
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to an array", value)
+	}
+}
+
+// coerceVector converts the value of a vector column
+func coerceVector(value any) (models.C11yVector, error) {
+	switch v := value.(type) {
+	case []float32:
+		return v, nil
+	case []float64:
+		vector := make(models.C11yVector, len(v))
+		for i, f := range v {
+			vector[i] = float32(f)
+		}
+		return vector, nil
+	}
+	items, err := coerceList(value)
+	if err != nil {
+		return nil, err
+	}
+	vector := make(models.C11yVector, len(items))
+	for i, item := range items {
+		f, err := coerceNumber(item)
+		if err != nil {
+			return nil, fmt.Errorf("vector item %d: %w", i, err)
+		}
+		vector[i] = float32(f.(float64))
+	}
+	return vector, nil
+}
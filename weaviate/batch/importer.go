@@ -0,0 +1,430 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/retry"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/schema"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/util"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const defaultImportBatchSize = 100
+
+// ImportProgress is reported after every batch of an import
+type ImportProgress struct {
+	// Rows is the number of rows read, including the rows skipped when resuming
+	Rows int64
+	// Objects is the number of objects stored
+	Objects int
+	// Failed is the number of rows which could not be converted or stored
+	Failed int
+}
+
+// InvalidRow is a row which could not be converted to an object
+type InvalidRow struct {
+	// Row is the number of the row, starting at 0
+	Row int64
+	Err error
+}
+
+// ImportResult of an Importer
+type ImportResult struct {
+	// Rows is the number of rows read, including the rows skipped when resuming
+	Rows int64
+	// Skipped is the number of rows skipped, as a checkpoint marked them as imported
+	Skipped int64
+	// Objects is the number of objects stored
+	Objects int
+	// InvalidRows could not be converted to objects
+	InvalidRows []InvalidRow
+	// FailedObjects could not be stored after all attempts
+	FailedObjects []FailedObject
+}
+
+// Checkpoint stores how many rows of a source have been imported, so that an
+// interrupted import resumes after them.
+type Checkpoint interface {
+	// Load returns the number of imported rows, 0 if nothing has been imported yet
+	Load() (int64, error)
+	// Save stores the number of imported rows
+	Save(rows int64) error
+}
+
+// FileCheckpoint returns a Checkpoint which keeps the number of imported rows in a file
+func FileCheckpoint(path string) Checkpoint {
+	return fileCheckpoint(path)
+}
+
+type fileCheckpoint string
+
+func (f fileCheckpoint) Load() (int64, error) {
+	data, err := os.ReadFile(string(f))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+func (f fileCheckpoint) Save(rows int64) error {
+	// write a temporary file first, so that an interrupted write does not lose the checkpoint
+	tmp := string(f) + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(rows, 10)), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, string(f))
+}
+
+// Importer builder to import the rows of a JSONL, CSV or Parquet source into a class.
+// Columns named like a property of the class, or mapped to one with WithColumn, are
+// converted to the data type of the property. Other columns are ignored.
+type Importer struct {
+	api              *API
+	className        string
+	tenant           string
+	consistencyLevel string
+	format           Format
+	reader           io.Reader
+	comma            rune
+	columns          map[string]string
+	idColumn         string
+	idColumns        []string
+	vectorColumn     string
+	vectorColumns    map[string]string
+	batchSize        int
+	retry            *retry.Config
+	progress         func(ImportProgress)
+	checkpoint       Checkpoint
+}
+
+// Importer returns a builder which imports rows from a file into a class
+func (batch *API) Importer() *Importer {
+	return &Importer{
+		api:           batch,
+		columns:       map[string]string{},
+		vectorColumns: map[string]string{},
+		batchSize:     defaultImportBatchSize,
+	}
+}
+
+// WithClassName of the class the objects are imported into
+func (i *Importer) WithClassName(className string) *Importer {
+	i.className = className
+	return i
+}
+
+// WithTenant of the imported objects
+func (i *Importer) WithTenant(tenant string) *Importer {
+	i.tenant = tenant
+	return i
+}
+
+// WithConsistencyLevel determines how many replicas must acknowledge a request
+// before it is considered successful. Can be one of 'ALL', 'ONE', or 'QUORUM'.
+func (i *Importer) WithConsistencyLevel(cl string) *Importer {
+	i.consistencyLevel = cl
+	return i
+}
+
+// WithReader sets the source of the rows and its format. Parquet sources need random
+// access, sources other than an io.ReaderAt of known size, like *os.File, are read into memory.
+func (i *Importer) WithReader(format Format, r io.Reader) *Importer {
+	i.format = format
+	i.reader = r
+	return i
+}
+
+// WithCSVComma sets the field delimiter of CSV sources, ',' by default
+func (i *Importer) WithCSVComma(comma rune) *Importer {
+	i.comma = comma
+	return i
+}
+
+// WithColumn maps a column to a property with a different name
+func (i *Importer) WithColumn(column, property string) *Importer {
+	i.columns[column] = property
+	return i
+}
+
+// WithIDColumn takes the UUIDs of the objects from a column
+func (i *Importer) WithIDColumn(column string) *Importer {
+	i.idColumn = column
+	return i
+}
+
+// WithDeterministicID generates the UUIDs of the objects from the values of the given
+// columns, or from all properties if no column is given, see util.GenerateUUID5FromProperties.
+// The values are converted to the data types of their properties first, so that the IDs
+// do not depend on the format of the source.
+// Importing the same rows again overwrites the objects instead of duplicating them.
+func (i *Importer) WithDeterministicID(columns ...string) *Importer {
+	i.idColumns = columns
+	if i.idColumns == nil {
+		i.idColumns = []string{}
+	}
+	return i
+}
+
+// WithVectorColumn takes the vectors of the objects from a column
+func (i *Importer) WithVectorColumn(column string) *Importer {
+	i.vectorColumn = column
+	return i
+}
+
+// WithNamedVectorColumn takes the named vector of the objects from a column
+func (i *Importer) WithNamedVectorColumn(name, column string) *Importer {
+	i.vectorColumns[name] = column
+	return i
+}
+
+// WithBatchSize sets the number of objects sent in one request, 100 by default
+func (i *Importer) WithBatchSize(batchSize int) *Importer {
+	if batchSize > 0 {
+		i.batchSize = batchSize
+	}
+	return i
+}
+
+// WithRetry sets the policy for batches which fail and for objects whose error is
+// retryable, see ErrorCategory.Retryable. Defaults to 3 attempts.
+func (i *Importer) WithRetry(config *retry.Config) *Importer {
+	i.retry = config
+	return i
+}
+
+// WithProgress calls progress after every batch
+func (i *Importer) WithProgress(progress func(ImportProgress)) *Importer {
+	i.progress = progress
+	return i
+}
+
+// WithCheckpoint stores the number of imported rows after every batch. An import
+// with a checkpoint of an earlier run skips the rows the run imported. The source
+// must return the rows in the same order.
+func (i *Importer) WithCheckpoint(checkpoint Checkpoint) *Importer {
+	i.checkpoint = checkpoint
+	return i
+}
+
+// Do reads all rows and imports them. Rows which cannot be converted and objects
+// which cannot be stored are reported in the result and do not stop the import.
+func (i *Importer) Do(ctx context.Context) (*ImportResult, error) {
+	if i.reader == nil {
+		return nil, errors.New("importer: no reader set")
+	}
+	class, err := schema.New(i.api.connection, nil).ClassGetter().WithClassName(i.className).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("importer: get class %s: %w", i.className, err)
+	}
+	dataTypes := make(map[string][]string, len(class.Properties))
+	for _, prop := range class.Properties {
+		dataTypes[prop.Name] = prop.DataType
+	}
+	rows, err := newRowReader(i.format, i.reader, i.comma)
+	if err != nil {
+		return nil, fmt.Errorf("importer: %w", err)
+	}
+
+	var skip int64
+	if i.checkpoint != nil {
+		if skip, err = i.checkpoint.Load(); err != nil {
+			return nil, fmt.Errorf("importer: load checkpoint: %w", err)
+		}
+	}
+	result := &ImportResult{}
+	// sent is the number of rows handled by the last batch
+	sent := skip
+	objects := make([]*models.Object, 0, i.batchSize)
+	for {
+		row, err := rows.next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return result, fmt.Errorf("importer: read row %d: %w", result.Rows, err)
+		}
+		result.Rows++
+		if result.Rows <= skip {
+			result.Skipped++
+			continue
+		}
+		object, err := i.object(row, dataTypes)
+		if err != nil {
+			result.InvalidRows = append(result.InvalidRows, InvalidRow{Row: result.Rows - 1, Err: err})
+		} else {
+			objects = append(objects, object)
+		}
+		if len(objects) == i.batchSize {
+			if err := i.send(ctx, objects, result); err != nil {
+				return result, err
+			}
+			objects, sent = objects[:0], result.Rows
+		}
+	}
+	if result.Rows > sent {
+		if err := i.send(ctx, objects, result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+// send imports a batch, saves the checkpoint and reports the progress
+func (i *Importer) send(ctx context.Context, objects []*models.Object, result *ImportResult) error {
+	retryConfig := i.retry
+	if retryConfig == nil {
+		retryConfig = &retry.Config{}
+	}
+	for attempt := 1; len(objects) > 0; attempt++ {
+		// failed requests are retried by the connection, an error aborts the import
+		// without saving the checkpoint, so that a resumed import sends the batch again
+		batchResult, err := i.api.ObjectsBatcher().
+			WithObjects(objects...).
+			WithConsistencyLevel(i.consistencyLevel).
+			DoWithResult(ctx)
+		if err != nil {
+			return fmt.Errorf("importer: send batch: %w", err)
+		}
+		result.Objects += len(batchResult.Succeeded)
+		final := attempt >= retryConfig.Attempts()
+		objects = objects[:0]
+		for _, f := range batchResult.Failed {
+			if final || !f.Category.Retryable() {
+				result.FailedObjects = append(result.FailedObjects, f)
+			} else {
+				objects = append(objects, f.Object)
+			}
+		}
+		if len(objects) == 0 {
+			break
+		}
+		if err := retry.Wait(ctx, retryConfig.Backoff(attempt)); err != nil {
+			return err
+		}
+	}
+	if i.checkpoint != nil {
+		if err := i.checkpoint.Save(result.Rows); err != nil {
+			return fmt.Errorf("importer: save checkpoint: %w", err)
+		}
+	}
+	if i.progress != nil {
+		i.progress(ImportProgress{
+			Rows:    result.Rows,
+			Objects: result.Objects,
+			Failed:  len(result.InvalidRows) + len(result.FailedObjects),
+		})
+	}
+	return nil
+}
+
+// object converts a row to an object of the class
+func (i *Importer) object(row map[string]any, dataTypes map[string][]string) (*models.Object, error) {
+	object := &models.Object{Class: i.className, Tenant: i.tenant}
+	properties := map[string]any{}
+	for column, value := range row {
+		if value == nil || i.isReservedColumn(column) {
+			continue
+		}
+		property := column
+		if mapped, ok := i.columns[column]; ok {
+			property = mapped
+		}
+		dataType, ok := dataTypes[property]
+		if !ok {
+			continue
+		}
+		converted, err := coerce(value, dataType)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", column, err)
+		}
+		properties[property] = converted
+	}
+	object.Properties = properties
+
+	id, err := i.objectID(row, properties)
+	if err != nil {
+		return nil, err
+	}
+	object.ID = id
+	if value := row[i.vectorColumn]; i.vectorColumn != "" && value != nil {
+		if object.Vector, err = coerceVector(value); err != nil {
+			return nil, fmt.Errorf("column %s: %w", i.vectorColumn, err)
+		}
+	}
+	for name, column := range i.vectorColumns {
+		value := row[column]
+		if value == nil {
+			continue
+		}
+		vector, err := coerceVector(value)
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %w", column, err)
+		}
+		if object.Vectors == nil {
+			object.Vectors = models.Vectors{}
+		}
+		object.Vectors[name] = vector
+	}
+	return object, nil
+}
+
+func (i *Importer) objectID(row, properties map[string]any) (strfmt.UUID, error) {
+	if i.idColumn != "" {
+		if row[i.idColumn] == nil {
+			return "", fmt.Errorf("column %s: missing id", i.idColumn)
+		}
+		value, err := coerceString(row[i.idColumn])
+		if err != nil {
+			return "", fmt.Errorf("column %s: %w", i.idColumn, err)
+		}
+		id, err := uuid.Parse(value.(string))
+		if err != nil {
+			return "", fmt.Errorf("column %s: %w", i.idColumn, err)
+		}
+		return strfmt.UUID(id.String()), nil
+	}
+	if i.idColumns == nil {
+		return "", nil
+	}
+	if len(i.idColumns) == 0 {
+		return util.GenerateUUID5(properties), nil
+	}
+	// hash the converted values of the properties, so that the same rows of different
+	// formats, e.g. "1" of a CSV and 1 of a JSONL source, result in the same IDs
+	values := make(map[string]any, len(i.idColumns))
+	for _, column := range i.idColumns {
+		property := column
+		if mapped, ok := i.columns[column]; ok {
+			property = mapped
+		}
+		if value, ok := properties[property]; ok {
+			values[column] = value
+		} else if row[column] != nil {
+			values[column] = row[column]
+		}
+	}
+	return util.GenerateUUID5FromProperties(values, i.idColumns...), nil
+}
+
+func (i *Importer) isReservedColumn(column string) bool {
+	if column == i.vectorColumn || column == i.idColumn {
+		return true
+	}
+	for _, c := range i.vectorColumns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
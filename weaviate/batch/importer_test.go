@@ -0,0 +1,231 @@
+package batch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// fakeImportServer serves the schema of class "Article" and stores batches of objects.
+// Objects with the title "invalid" fail.
+type fakeImportServer struct {
+	mutex   sync.Mutex
+	objects []*models.Object
+}
+
+func (f *fakeImportServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/v1/schema/Article" {
+		json.NewEncoder(w).Encode(models.Class{Class: "Article", Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}},
+			{Name: "wordCount", DataType: []string{"int"}},
+			{Name: "score", DataType: []string{"number"}},
+			{Name: "published", DataType: []string{"boolean"}},
+			{Name: "date", DataType: []string{"date"}},
+			{Name: "tags", DataType: []string{"text[]"}},
+		}})
+		return
+	}
+	var body ObjectsBatchRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	responses := make([]models.ObjectsGetResponse, len(body.Objects))
+	for i, obj := range body.Objects {
+		responses[i].Object = *obj
+		if props, ok := obj.Properties.(map[string]any); ok && props["title"] == "invalid" {
+			responses[i].Result = &models.ObjectsGetResponseAO2Result{Errors: &models.ErrorResponse{
+				Error: []*models.ErrorResponseErrorItems0{{Message: "invalid object property 'title'"}},
+			}}
+			continue
+		}
+		f.objects = append(f.objects, obj)
+	}
+	json.NewEncoder(w).Encode(responses)
+}
+
+func newTestImporter(t *testing.T) (*Importer, *fakeImportServer) {
+	t.Helper()
+	handler := &fakeImportServer{}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+	return New(con, nil, nil).Importer().WithClassName("Article"), handler
+}
+
+func TestImporter(t *testing.T) {
+	t.Run("JSONL with vectors and ids", func(t *testing.T) {
+		importer, server := newTestImporter(t)
+		source := `{"id": "00000000-0000-0000-0000-000000000001", "title": "A", "wordCount": 10, "vec": [1, 2], "extra": 1}
+{"id": "00000000-0000-0000-0000-000000000002", "title": "B", "wordCount": 20.0, "tags": ["x", "y"], "vec": [3, 4]}
+`
+		result, err := importer.WithReader(FormatJSONL, strings.NewReader(source)).
+			WithIDColumn("id").
+			WithVectorColumn("vec").
+			Do(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), result.Rows)
+		assert.Equal(t, 2, result.Objects)
+		require.Len(t, server.objects, 2)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000001", server.objects[0].ID.String())
+		assert.Equal(t, models.C11yVector{1, 2}, server.objects[0].Vector)
+		assert.Equal(t, map[string]any{"title": "A", "wordCount": float64(10)}, server.objects[0].Properties)
+		assert.Equal(t, []any{"x", "y"}, server.objects[1].Properties.(map[string]any)["tags"])
+	})
+
+	t.Run("CSV with type coercion and invalid rows", func(t *testing.T) {
+		importer, server := newTestImporter(t)
+		source := "name;wordCount;score;published;date;tags;v\n" +
+			"A;10;1.5;true;2024-01-02;\"[\"\"x\"\"]\";[0.5]\n" +
+			"B;ten;;;;;\n" +
+			"invalid;1;;;;;\n"
+		result, err := importer.WithReader(FormatCSV, strings.NewReader(source)).
+			WithCSVComma(';').
+			WithColumn("name", "title").
+			WithNamedVectorColumn("default", "v").
+			Do(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Objects)
+		require.Len(t, result.InvalidRows, 1)
+		assert.Equal(t, int64(1), result.InvalidRows[0].Row)
+		require.Len(t, result.FailedObjects, 1)
+		assert.Equal(t, ErrorCategoryValidation, result.FailedObjects[0].Category)
+		require.Len(t, server.objects, 1)
+		assert.Equal(t, map[string]any{
+			"title": "A", "wordCount": float64(10), "score": 1.5, "published": true,
+			"date": "2024-01-02T00:00:00Z", "tags": []any{"x"},
+		}, server.objects[0].Properties)
+		assert.Equal(t, []float32{0.5}, server.objects[0].Vectors["default"])
+	})
+
+	t.Run("Parquet", func(t *testing.T) {
+		type row struct {
+			Title     string    `parquet:"title"`
+			WordCount int64     `parquet:"wordCount"`
+			Vector    []float32 `parquet:"vector,list"`
+		}
+		var buf bytes.Buffer
+		writer := parquet.NewGenericWriter[row](&buf)
+		_, err := writer.Write([]row{{"A", 1, []float32{1, 2}}, {"B", 2, []float32{3, 4}}})
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		importer, server := newTestImporter(t)
+		result, err := importer.WithReader(FormatParquet, bytes.NewReader(buf.Bytes())).
+			WithVectorColumn("vector").
+			Do(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Objects)
+		require.Len(t, server.objects, 2)
+		assert.Equal(t, map[string]any{"title": "B", "wordCount": float64(2)}, server.objects[1].Properties)
+		assert.Equal(t, models.C11yVector{3, 4}, server.objects[1].Vector)
+	})
+
+	t.Run("deterministic ids", func(t *testing.T) {
+		source := "title,wordCount\nA,1\nA,2\n"
+		importer, server := newTestImporter(t)
+		_, err := importer.WithReader(FormatCSV, strings.NewReader(source)).WithDeterministicID("title").Do(context.Background())
+		require.NoError(t, err)
+		importer, again := newTestImporter(t)
+		_, err = importer.WithReader(FormatCSV, strings.NewReader(source)).WithDeterministicID().Do(context.Background())
+		require.NoError(t, err)
+		require.Len(t, server.objects, 2)
+		require.Len(t, again.objects, 2)
+		assert.NotEmpty(t, server.objects[0].ID)
+		assert.Equal(t, server.objects[0].ID, server.objects[1].ID)
+		assert.NotEqual(t, again.objects[0].ID, again.objects[1].ID)
+	})
+
+	t.Run("deterministic ids do not depend on the format", func(t *testing.T) {
+		for _, columns := range [][]string{{}, {"title", "wordCount"}, {"wordCount"}} {
+			importer, csv := newTestImporter(t)
+			_, err := importer.WithReader(FormatCSV, strings.NewReader("title,wordCount\nA,1\n")).
+				WithDeterministicID(columns...).Do(context.Background())
+			require.NoError(t, err)
+			importer, jsonl := newTestImporter(t)
+			_, err = importer.WithReader(FormatJSONL, strings.NewReader(`{"title": "A", "wordCount": 1}`)).
+				WithDeterministicID(columns...).Do(context.Background())
+			require.NoError(t, err)
+			require.Len(t, csv.objects, 1)
+			require.Len(t, jsonl.objects, 1)
+			assert.Equal(t, csv.objects[0].ID, jsonl.objects[0].ID, "%v", columns)
+		}
+	})
+
+	t.Run("missing id", func(t *testing.T) {
+		importer, _ := newTestImporter(t)
+		result, err := importer.WithReader(FormatCSV, strings.NewReader("id,title\n,A\n")).
+			WithIDColumn("id").Do(context.Background())
+		require.NoError(t, err)
+		require.Len(t, result.InvalidRows, 1)
+		assert.ErrorContains(t, result.InvalidRows[0].Err, "column id")
+	})
+
+	t.Run("resumes from checkpoint with progress", func(t *testing.T) {
+		checkpoint := FileCheckpoint(filepath.Join(t.TempDir(), "checkpoint"))
+		require.NoError(t, checkpoint.Save(3))
+		source := "title\nA\nB\nC\nD\nE\nF\nG\n"
+		importer, server := newTestImporter(t)
+		var progress []ImportProgress
+		result, err := importer.WithReader(FormatCSV, strings.NewReader(source)).
+			WithBatchSize(2).
+			WithCheckpoint(checkpoint).
+			WithProgress(func(p ImportProgress) { progress = append(progress, p) }).
+			Do(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), result.Skipped)
+		assert.Equal(t, 4, result.Objects)
+		require.Len(t, server.objects, 4)
+		assert.Equal(t, map[string]any{"title": "D"}, server.objects[0].Properties)
+		assert.Equal(t, []ImportProgress{{Rows: 5, Objects: 2}, {Rows: 7, Objects: 4}}, progress)
+		rows, err := checkpoint.Load()
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), rows)
+	})
+}
+
+func TestCoerce(t *testing.T) {
+	tests := []struct {
+		value    any
+		dataType string
+		expected any
+		fails    bool
+	}{
+		{value: "12", dataType: "int", expected: int64(12)},
+		{value: json.Number("3"), dataType: "int", expected: int64(3)},
+		{value: 2.5, dataType: "int", fails: true},
+		{value: "1.5", dataType: "number", expected: 1.5},
+		{value: uint64(3), dataType: "number", expected: float64(3)},
+		{value: "yes", dataType: "boolean", fails: true},
+		{value: "2024-01-02 03:04:05", dataType: "date", expected: "2024-01-02T03:04:05Z"},
+		{value: int64(7), dataType: "text", expected: "7"},
+		{value: "[1, 2]", dataType: "int[]", expected: []any{int64(1), int64(2)}},
+		{value: `{"latitude": 1, "longitude": 2}`, dataType: "geoCoordinates", expected: map[string]any{"latitude": float64(1), "longitude": float64(2)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dataType, func(t *testing.T) {
+			actual, err := coerce(tt.value, []string{tt.dataType})
+			if tt.fails {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,517 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/cluster"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/retry"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	defaultManagerBatchSize     = 100
+	defaultManagerMaxBatchSize  = 1000
+	defaultManagerConcurrency   = 2
+	defaultManagerFlushInterval = time.Second
+	minManagerBatchSize         = 10
+	// targetLatency is the request latency dynamic sizing aims at
+	targetLatency = 2 * time.Second
+	// statsInterval between two reads of the server's batch queue statistics
+	statsInterval = 5 * time.Second
+)
+
+// ErrManagerClosed is returned when adding to a manager which has been closed.
+var ErrManagerClosed = errors.New("batch manager is closed")
+
+// ManagerConfig of a Manager
+type ManagerConfig struct {
+	// BatchSize is the number of objects or references sent in one request,
+	// with DynamicSizing it is the initial size. Defaults to 100.
+	BatchSize int
+	// DynamicSizing adjusts the batch size to the observed request latency
+	// and to the length of the servers' batch queues.
+	DynamicSizing bool
+	// MaxBatchSize caps the batch size chosen by DynamicSizing. Defaults to 1000.
+	MaxBatchSize int
+	// Concurrency is the number of requests sent at the same time. Defaults to 2.
+	Concurrency int
+	// FlushInterval after which buffered objects and references are sent,
+	// even if the batch is not full. Defaults to 1s.
+	FlushInterval time.Duration
+	// Retry policy for failed requests and for objects whose error is retryable,
+	// see ErrorCategory.Retryable. Defaults to 3 attempts.
+	Retry *retry.Config
+	// ConsistencyLevel of the requests, one of 'ALL', 'ONE', or 'QUORUM'.
+	ConsistencyLevel string
+	// IDKeys gives objects without an ID one derived from the values of these property
+	// keys, see ObjectsBatcher.WithDeterministicIDs. An empty, non-nil slice derives
+	// the IDs from all properties. If nil, objects without an ID are given a random one.
+	IDKeys []string
+}
+
+// FailedReference is a reference which could not be stored after all attempts
+type FailedReference struct {
+	Reference *models.BatchReference
+	Err       error
+}
+
+// ManagerSummary of everything a Manager sent so far
+type ManagerSummary struct {
+	// Objects is the number of stored objects
+	Objects int
+	// References is the number of stored references
+	References int
+	// FailedObjects could not be stored after all attempts
+	FailedObjects []FailedObject
+	// FailedReferences could not be stored after all attempts
+	FailedReferences []FailedReference
+	// Requests is the number of batch requests sent, including retries
+	Requests int
+	// Retries is the number of requests which resent failed objects or references
+	Retries int
+	// BatchSize is the current batch size
+	BatchSize int
+}
+
+// Manager buffers objects and references added from any number of goroutines and
+// sends them in the background, when a batch is full or the flush interval has passed.
+// Failed objects and references are retried and reported in the summary.
+type Manager struct {
+	api       *API
+	config    ManagerConfig
+	retry     *retry.Config
+	batchSize atomic.Int64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mutex      sync.Mutex
+	objects    []*models.Object
+	references []*models.BatchReference
+	summary    ManagerSummary
+	closed     bool
+	// inFlight counts the requests being sent, idle is closed once it drops to 0
+	inFlight int
+	idle     chan struct{}
+
+	slots   chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// Manager returns a batch manager which sends objects and references in the background.
+// It must be closed with Manager.Close.
+func (batch *API) Manager(config ManagerConfig) *Manager {
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultManagerBatchSize
+	}
+	if config.MaxBatchSize <= 0 {
+		config.MaxBatchSize = max(defaultManagerMaxBatchSize, config.BatchSize)
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = defaultManagerConcurrency
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaultManagerFlushInterval
+	}
+	retryConfig := config.Retry
+	if retryConfig == nil {
+		retryConfig = &retry.Config{}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &Manager{
+		api:     batch,
+		config:  config,
+		retry:   retryConfig,
+		ctx:     ctx,
+		cancel:  cancel,
+		slots:   make(chan struct{}, config.Concurrency),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	m.batchSize.Store(int64(config.BatchSize))
+	go m.run()
+	return m
+}
+
+// AddObjects buffers objects, which are sent once a batch is full. It blocks
+// while all concurrent requests are in flight. Objects without an ID are given one,
+// see ManagerConfig.IDKeys, which is set on the given objects. Retries resend the
+// objects with the same ID, so that a request which timed out after the server
+// stored its objects does not create duplicates.
+func (m *Manager) AddObjects(ctx context.Context, objects ...*models.Object) error {
+	m.mutex.Lock()
+	if m.closed {
+		m.mutex.Unlock()
+		return ErrManagerClosed
+	}
+	if m.config.IDKeys != nil {
+		assignIDs(objects, m.config.IDKeys)
+	}
+	for _, obj := range objects {
+		if obj != nil && obj.ID == "" {
+			obj.ID = strfmt.UUID(uuid.NewString())
+		}
+	}
+	m.objects = append(m.objects, objects...)
+	m.mutex.Unlock()
+	return m.dispatch(ctx, false)
+}
+
+// AddReferences buffers references, which are sent once a batch is full. It blocks
+// while all concurrent requests are in flight. References whose objects have not been
+// stored yet fail and are retried.
+func (m *Manager) AddReferences(ctx context.Context, references ...*models.BatchReference) error {
+	m.mutex.Lock()
+	if m.closed {
+		m.mutex.Unlock()
+		return ErrManagerClosed
+	}
+	m.references = append(m.references, references...)
+	m.mutex.Unlock()
+	return m.dispatch(ctx, false)
+}
+
+// Flush sends every buffered object and then every buffered reference, and waits
+// until all requests, including retries, have finished. It returns the summary of
+// everything sent so far.
+func (m *Manager) Flush(ctx context.Context) (*ManagerSummary, error) {
+	if err := m.flush(ctx); err != nil {
+		return nil, err
+	}
+	return m.Summary(), nil
+}
+
+// Close flushes the manager and stops it. Objects and references cannot be added afterwards.
+// If the flush fails, e.g. as ctx is done first, the requests in flight are cancelled.
+func (m *Manager) Close(ctx context.Context) (*ManagerSummary, error) {
+	m.mutex.Lock()
+	alreadyClosed := m.closed
+	m.closed = true
+	m.mutex.Unlock()
+	if !alreadyClosed {
+		close(m.done)
+		<-m.stopped
+	}
+	err := m.flush(ctx)
+	m.cancel()
+	if err != nil {
+		return nil, err
+	}
+	return m.Summary(), nil
+}
+
+// Summary returns the summary of everything sent so far.
+func (m *Manager) Summary() *ManagerSummary {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	summary := m.summary
+	summary.FailedObjects = slices.Clone(m.summary.FailedObjects)
+	summary.FailedReferences = slices.Clone(m.summary.FailedReferences)
+	summary.BatchSize = int(m.batchSize.Load())
+	return &summary
+}
+
+func (m *Manager) flush(ctx context.Context) error {
+	// objects are sent first, so that references can point to them
+	if err := m.dispatchObjects(ctx, true); err != nil {
+		return err
+	}
+	if err := m.wait(ctx); err != nil {
+		return err
+	}
+	if err := m.dispatchReferences(ctx, true); err != nil {
+		return err
+	}
+	return m.wait(ctx)
+}
+
+// wait blocks until no request is in flight, including requests started while waiting
+func (m *Manager) wait(ctx context.Context) error {
+	for {
+		m.mutex.Lock()
+		if m.inFlight == 0 {
+			m.mutex.Unlock()
+			return nil
+		}
+		idle := m.idle
+		m.mutex.Unlock()
+		select {
+		case <-idle:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// run flushes the buffers periodically and reads the servers' batch queue statistics
+func (m *Manager) run() {
+	defer close(m.stopped)
+	flushTicker := time.NewTicker(m.config.FlushInterval)
+	defer flushTicker.Stop()
+	statsTicker := time.NewTicker(statsInterval)
+	defer statsTicker.Stop()
+	for {
+		select {
+		case <-m.done:
+			return
+		case <-flushTicker.C:
+			if err := m.dispatch(m.ctx, true); err != nil {
+				m.api.connection.Logger().Warn("batch manager: failed to flush", "error", err)
+			}
+		case <-statsTicker.C:
+			if m.config.DynamicSizing {
+				m.adjustToQueue()
+			}
+		}
+	}
+}
+
+// dispatch sends the full batches, or everything buffered if all is set
+func (m *Manager) dispatch(ctx context.Context, all bool) error {
+	if err := m.dispatchObjects(ctx, all); err != nil {
+		return err
+	}
+	return m.dispatchReferences(ctx, all)
+}
+
+func (m *Manager) dispatchObjects(ctx context.Context, all bool) error {
+	for {
+		m.mutex.Lock()
+		objects := take(&m.objects, int(m.batchSize.Load()), all)
+		m.mutex.Unlock()
+		if len(objects) == 0 {
+			return nil
+		}
+		if err := m.acquire(ctx); err != nil {
+			// put the objects back, so that they are sent later
+			m.mutex.Lock()
+			m.objects = append(objects, m.objects...)
+			m.mutex.Unlock()
+			return err
+		}
+		go func() {
+			defer m.release()
+			m.sendObjects(objects)
+		}()
+	}
+}
+
+func (m *Manager) dispatchReferences(ctx context.Context, all bool) error {
+	for {
+		m.mutex.Lock()
+		references := take(&m.references, int(m.batchSize.Load()), all)
+		m.mutex.Unlock()
+		if len(references) == 0 {
+			return nil
+		}
+		if err := m.acquire(ctx); err != nil {
+			m.mutex.Lock()
+			m.references = append(references, m.references...)
+			m.mutex.Unlock()
+			return err
+		}
+		go func() {
+			defer m.release()
+			m.sendReferences(references)
+		}()
+	}
+}
+
+// take removes a batch of up to size items from buffer. Unless all is set,
+// it only returns full batches.
+func take[T any](buffer *[]T, size int, all bool) []T {
+	if len(*buffer) == 0 || (!all && len(*buffer) < size) {
+		return nil
+	}
+	n := min(size, len(*buffer))
+	batch := slices.Clone((*buffer)[:n])
+	*buffer = (*buffer)[n:]
+	return batch
+}
+
+func (m *Manager) acquire(ctx context.Context) error {
+	select {
+	case m.slots <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	m.mutex.Lock()
+	if m.inFlight == 0 {
+		m.idle = make(chan struct{})
+	}
+	m.inFlight++
+	m.mutex.Unlock()
+	return nil
+}
+
+func (m *Manager) release() {
+	m.mutex.Lock()
+	m.inFlight--
+	if m.inFlight == 0 {
+		close(m.idle)
+	}
+	m.mutex.Unlock()
+	<-m.slots
+}
+
+func (m *Manager) sendObjects(objects []*models.Object) {
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		responses, err := m.api.ObjectsBatcher().
+			WithObjects(objects...).
+			WithConsistencyLevel(m.config.ConsistencyLevel).
+			Do(m.ctx)
+		m.observe(time.Since(start), len(objects))
+
+		result := &ObjectsBatchResult{}
+		if err != nil {
+			for i, obj := range objects {
+				result.Failed = append(result.Failed, FailedObject{Object: obj, Index: i, Category: ErrorCategoryUnknown, Err: err})
+			}
+		} else {
+			result = NewObjectsBatchResult(objects, responses)
+		}
+		// only the retryable failures are sent again, the others fail right away
+		retryable := result.RetryableObjects()
+		final := attempt >= m.retry.Attempts() || m.ctx.Err() != nil
+		m.mutex.Lock()
+		m.summary.Requests++
+		m.summary.Objects += len(result.Succeeded)
+		for _, f := range result.Failed {
+			if final || !f.Category.Retryable() {
+				m.summary.FailedObjects = append(m.summary.FailedObjects, f)
+			}
+		}
+		done := final || len(retryable) == 0
+		if !done {
+			m.summary.Retries++
+		}
+		m.mutex.Unlock()
+		if done {
+			return
+		}
+		if err := retry.Wait(m.ctx, m.retry.Backoff(attempt)); err != nil {
+			m.mutex.Lock()
+			for _, obj := range retryable {
+				m.summary.FailedObjects = append(m.summary.FailedObjects, FailedObject{Object: obj, Category: ErrorCategoryUnknown, Err: err})
+			}
+			m.mutex.Unlock()
+			return
+		}
+		objects = retryable
+	}
+}
+
+func (m *Manager) sendReferences(references []*models.BatchReference) {
+	for attempt := 1; ; attempt++ {
+		start := time.Now()
+		responses, err := m.api.ReferencesBatcher().
+			WithReferences(references...).
+			WithConsistencyLevel(m.config.ConsistencyLevel).
+			Do(m.ctx)
+		m.observe(time.Since(start), len(references))
+
+		var failed []FailedReference
+		if err != nil {
+			for _, ref := range references {
+				failed = append(failed, FailedReference{Reference: ref, Err: err})
+			}
+		} else {
+			failed = failedReferences(references, responses)
+		}
+		m.mutex.Lock()
+		m.summary.Requests++
+		m.summary.References += len(references) - len(failed)
+		done := len(failed) == 0 || attempt >= m.retry.Attempts() || m.ctx.Err() != nil
+		if done {
+			m.summary.FailedReferences = append(m.summary.FailedReferences, failed...)
+		} else {
+			m.summary.Retries++
+		}
+		m.mutex.Unlock()
+		if done || retry.Wait(m.ctx, m.retry.Backoff(attempt)) != nil {
+			return
+		}
+
+		references = references[:0]
+		for _, f := range failed {
+			references = append(references, f.Reference)
+		}
+	}
+}
+
+// failedReferences returns the references whose response contains an error
+func failedReferences(references []*models.BatchReference, responses []models.BatchReferenceResponse) []FailedReference {
+	var failed []FailedReference
+	for i, response := range responses {
+		if i < len(references) && response.Result != nil && isFailed(response.Result.Errors, response.Result.Status) {
+			failed = append(failed, FailedReference{Reference: references[i], Err: responseError(response.Result.Errors)})
+		}
+	}
+	return failed
+}
+
+// observe adjusts the batch size to the latency of a request with size items
+func (m *Manager) observe(latency time.Duration, size int) {
+	if !m.config.DynamicSizing {
+		return
+	}
+	current := int(m.batchSize.Load())
+	switch {
+	case latency > targetLatency:
+		m.setBatchSize(current / 2)
+	case latency < targetLatency/2 && size >= current:
+		// only full batches tell whether a larger batch would still be fast
+		m.setBatchSize(current + current/2)
+	}
+}
+
+// adjustToQueue shrinks the batch size while the servers' batch queues take
+// longer than the target latency to drain.
+func (m *Manager) adjustToQueue() {
+	ctx, cancel := context.WithTimeout(m.ctx, statsInterval)
+	defer cancel()
+	nodesStatus, err := cluster.New(m.api.connection).NodesStatusGetter().WithOutput("verbose").Do(ctx)
+	if err != nil {
+		m.api.connection.Logger().Debug("batch manager: failed to read batch queue statistics", "error", err)
+		return
+	}
+	var queueLength, ratePerSecond int64
+	for _, node := range nodesStatus.Nodes {
+		if node == nil || node.BatchStats == nil {
+			continue
+		}
+		if node.BatchStats.QueueLength != nil {
+			queueLength += *node.BatchStats.QueueLength
+		}
+		ratePerSecond += node.BatchStats.RatePerSecond
+	}
+	if ratePerSecond <= 0 {
+		return
+	}
+	if drain := time.Duration(float64(queueLength) / float64(ratePerSecond) * float64(time.Second)); drain > targetLatency {
+		m.setBatchSize(int(m.batchSize.Load()) / 2)
+	}
+}
+
+func (m *Manager) setBatchSize(size int) {
+	size = max(minManagerBatchSize, min(size, m.config.MaxBatchSize))
+	if int64(size) != m.batchSize.Swap(int64(size)) {
+		m.api.connection.Logger().Debug("batch manager: batch size changed", "batchSize", size)
+	}
+}
+
+// String returns a one line description of the summary.
+func (s *ManagerSummary) String() string {
+	return fmt.Sprintf("%d objects and %d references stored, %d objects and %d references failed, %d requests, %d retries",
+		s.Objects, s.References, len(s.FailedObjects), len(s.FailedReferences), s.Requests, s.Retries)
+}
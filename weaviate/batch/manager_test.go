@@ -0,0 +1,218 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/retry"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/util"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// fakeObjectsServer fails objects of class "Invalid" always and objects of class "Flaky" once.
+type fakeObjectsServer struct {
+	mutex     sync.Mutex
+	requests  atomic.Int32
+	batchSize []int
+	flaky     map[string]bool
+}
+
+func (f *fakeObjectsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests.Add(1)
+	var body ObjectsBatchRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.batchSize = append(f.batchSize, len(body.Objects))
+	responses := make([]models.ObjectsGetResponse, len(body.Objects))
+	for i, obj := range body.Objects {
+		responses[i].Object = *obj
+		failed := obj.Class == "Invalid" || (obj.Class == "Flaky" && !f.flaky[obj.ID.String()])
+		if obj.Class == "Flaky" {
+			f.flaky[obj.ID.String()] = true
+		}
+		if failed {
+			message := "connection reset"
+			if obj.Class == "Invalid" {
+				message = "invalid object property 'title'"
+			}
+			responses[i].Result = &models.ObjectsGetResponseAO2Result{Errors: &models.ErrorResponse{
+				Error: []*models.ErrorResponseErrorItems0{{Message: message}},
+			}}
+		}
+	}
+	json.NewEncoder(w).Encode(responses)
+}
+
+func newTestManager(t *testing.T, config ManagerConfig) (*Manager, *fakeObjectsServer) {
+	t.Helper()
+	handler := &fakeObjectsServer{flaky: map[string]bool{}}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+	return New(con, nil, nil).Manager(config), handler
+}
+
+func TestManager(t *testing.T) {
+	t.Run("sends full batches and flushes the rest", func(t *testing.T) {
+		manager, server := newTestManager(t, ManagerConfig{BatchSize: 10, FlushInterval: time.Hour})
+		for range 25 {
+			require.NoError(t, manager.AddObjects(context.Background(), &models.Object{Class: "Article"}))
+		}
+		summary, err := manager.Flush(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 25, summary.Objects)
+		assert.Empty(t, summary.FailedObjects)
+		assert.Equal(t, 3, summary.Requests)
+		assert.ElementsMatch(t, []int{10, 10, 5}, server.batchSize)
+
+		_, err = manager.Close(context.Background())
+		require.NoError(t, err)
+		assert.ErrorIs(t, manager.AddObjects(context.Background(), &models.Object{Class: "Article"}), ErrManagerClosed)
+	})
+
+	t.Run("retries retryable failed objects", func(t *testing.T) {
+		manager, _ := newTestManager(t, ManagerConfig{
+			Retry: &retry.Config{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+		})
+		require.NoError(t, manager.AddObjects(context.Background(),
+			&models.Object{Class: "Article", ID: "00000000-0000-0000-0000-000000000001"},
+			&models.Object{Class: "Flaky", ID: "00000000-0000-0000-0000-000000000002"},
+			&models.Object{Class: "Invalid", ID: "00000000-0000-0000-0000-000000000003"},
+		))
+		summary, err := manager.Close(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.Objects)
+		require.Len(t, summary.FailedObjects, 1)
+		assert.Equal(t, "Invalid", summary.FailedObjects[0].Object.Class)
+		assert.Equal(t, ErrorCategoryValidation, summary.FailedObjects[0].Category)
+		assert.Equal(t, 2, summary.Requests)
+		assert.Equal(t, 1, summary.Retries)
+	})
+
+	t.Run("flushes periodically", func(t *testing.T) {
+		manager, server := newTestManager(t, ManagerConfig{FlushInterval: 10 * time.Millisecond})
+		t.Cleanup(func() { manager.Close(context.Background()) })
+		require.NoError(t, manager.AddObjects(context.Background(), &models.Object{Class: "Article"}))
+		assert.Eventually(t, func() bool { return server.requests.Load() == 1 }, time.Second, 5*time.Millisecond)
+	})
+
+	t.Run("adjusts the batch size to the latency", func(t *testing.T) {
+		manager, _ := newTestManager(t, ManagerConfig{BatchSize: 100, MaxBatchSize: 120, DynamicSizing: true})
+		t.Cleanup(func() { manager.Close(context.Background()) })
+		manager.observe(10*time.Millisecond, 100)
+		assert.Equal(t, 120, manager.Summary().BatchSize)
+		manager.observe(3*time.Second, 120)
+		assert.Equal(t, 60, manager.Summary().BatchSize)
+	})
+
+	t.Run("derives IDs from the configured keys", func(t *testing.T) {
+		manager, _ := newTestManager(t, ManagerConfig{IDKeys: []string{"title"}})
+		t.Cleanup(func() { manager.Close(context.Background()) })
+		withID := &models.Object{Class: "Article", ID: "00000000-0000-0000-0000-000000000001"}
+		derived := &models.Object{Class: "Article", Properties: map[string]any{"title": "A", "wordCount": 1}}
+		require.NoError(t, manager.AddObjects(context.Background(), withID, derived))
+		summary, err := manager.Flush(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.Objects)
+		assert.Equal(t, strfmt.UUID("00000000-0000-0000-0000-000000000001"), withID.ID)
+		assert.Equal(t, util.GenerateUUID5("A"), derived.ID)
+	})
+
+	t.Run("resends failed requests with the same IDs", func(t *testing.T) {
+		var sent [][]strfmt.UUID
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body ObjectsBatchRequestBody
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			var ids []strfmt.UUID
+			for _, obj := range body.Objects {
+				ids = append(ids, obj.ID)
+			}
+			sent = append(sent, ids)
+			if len(sent) == 1 {
+				// the server may have stored the objects before the request failed
+				w.WriteHeader(http.StatusGatewayTimeout)
+				return
+			}
+			responses := make([]models.ObjectsGetResponse, len(body.Objects))
+			for i, obj := range body.Objects {
+				responses[i].Object = *obj
+			}
+			json.NewEncoder(w).Encode(responses)
+		}))
+		t.Cleanup(server.Close)
+		con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+		manager := New(con, nil, nil).Manager(ManagerConfig{
+			Retry: &retry.Config{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+		})
+		object := &models.Object{Class: "Article"}
+		require.NoError(t, manager.AddObjects(context.Background(), object, &models.Object{Class: "Article"}))
+		summary, err := manager.Close(context.Background())
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, summary.Objects)
+		require.Len(t, sent, 2)
+		assert.Equal(t, sent[0], sent[1])
+		assert.NotEmpty(t, sent[0][0])
+		assert.NotEqual(t, sent[0][0], sent[0][1])
+		assert.Equal(t, object.ID, sent[0][0])
+	})
+
+	t.Run("flushes while objects are added", func(t *testing.T) {
+		manager, _ := newTestManager(t, ManagerConfig{BatchSize: 5, Concurrency: 4, FlushInterval: time.Millisecond})
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 50 {
+					assert.NoError(t, manager.AddObjects(context.Background(), &models.Object{Class: "Article"}))
+				}
+			}()
+		}
+		for range 10 {
+			_, err := manager.Flush(context.Background())
+			require.NoError(t, err)
+		}
+		wg.Wait()
+		summary, err := manager.Close(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 200, summary.Objects)
+	})
+
+	t.Run("close cancels the requests in flight when it fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// the body is read, so that the closed connection cancels the context of the request
+			io.Copy(io.Discard, r.Body)
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		}))
+		t.Cleanup(server.Close)
+		con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, 10*time.Second, nil)
+		manager := New(con, nil, nil).Manager(ManagerConfig{BatchSize: 1, Retry: &retry.Config{MaxAttempts: 1}})
+		require.NoError(t, manager.AddObjects(context.Background(), &models.Object{Class: "Article"}))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := manager.Close(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Eventually(t, func() bool { return len(manager.Summary().FailedObjects) == 1 }, time.Second, 5*time.Millisecond)
+	})
+}
@@ -1,12 +1,16 @@
 package batch
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/util"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -22,6 +26,7 @@ type ObjectsBatcher struct {
 	grpcClient       *connection.GrpcClient
 	objects          []*models.Object
 	consistencyLevel string
+	idKeys           []string
 }
 
 // WithObjects adds objects to the batch
@@ -45,13 +50,48 @@ func (ob *ObjectsBatcher) WithConsistencyLevel(cl string) *ObjectsBatcher {
 	return ob
 }
 
+// WithDeterministicIDs gives objects without an ID one derived from the values of the
+// given property keys, or of all properties if no key is given, instead of a random one.
+// Sending the same objects again then updates them instead of adding duplicates,
+// see util.GenerateUUID5FromProperties. The IDs are set on the given objects.
+func (ob *ObjectsBatcher) WithDeterministicIDs(keys ...string) *ObjectsBatcher {
+	ob.idKeys = keys
+	if ob.idKeys == nil {
+		ob.idKeys = []string{}
+	}
+	return ob
+}
+
 func (ob *ObjectsBatcher) resetObjects() {
 	ob.objects = []*models.Object{}
 }
 
 // Do add all the objects in the builder to weaviate
 func (ob *ObjectsBatcher) Do(ctx context.Context) ([]models.ObjectsGetResponse, error) {
+	ctx, op := ob.connection.Telemetry().Start(ctx, "batch.ObjectsBatcher",
+		telemetry.ObjectCount(len(ob.objects)), telemetry.ConsistencyLevel(ob.consistencyLevel))
+	ob.connection.Telemetry().RecordBatchSize(ctx, len(ob.objects))
+	result, err := ob.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+// DoWithResult adds all the objects in the builder to weaviate and classifies the
+// failed objects, see ObjectsBatchResult.RetryableObjects to send them again.
+func (ob *ObjectsBatcher) DoWithResult(ctx context.Context) (*ObjectsBatchResult, error) {
+	objects := ob.objects
+	responses, err := ob.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewObjectsBatchResult(objects, responses), nil
+}
+
+func (ob *ObjectsBatcher) do(ctx context.Context) ([]models.ObjectsGetResponse, error) {
 	defer ob.resetObjects()
+	if ob.idKeys != nil {
+		assignIDs(ob.objects, ob.idKeys)
+	}
 	if ob.grpcClient != nil {
 		return ob.runGRPC(ctx)
 	}
@@ -66,6 +106,10 @@ func (ob *ObjectsBatcher) runREST(ctx context.Context) ([]models.ObjectsGetRespo
 	path := pathbuilder.BatchObjects(pathbuilder.Components{
 		ConsistencyLevel: ob.consistencyLevel,
 	})
+	if ob.hasObjectIDs() {
+		// objects with IDs are upserted, so repeating the request is safe
+		ctx = connection.WithRetryNonIdempotent(ctx)
+	}
 	responseData, responseErr := ob.connection.RunREST(ctx, path, http.MethodPost, body)
 	batchErr := except.CheckResponseDataErrorAndStatusCode(responseData, responseErr, 200)
 	if batchErr != nil {
@@ -77,6 +121,41 @@ func (ob *ObjectsBatcher) runREST(ctx context.Context) ([]models.ObjectsGetRespo
 	return parsedResponse, parseErr
 }
 
+func (ob *ObjectsBatcher) hasObjectIDs() bool {
+	for _, obj := range ob.objects {
+		if obj == nil || obj.ID == "" {
+			return false
+		}
+	}
+	return true
+}
+
 func (ob *ObjectsBatcher) runGRPC(ctx context.Context) ([]models.ObjectsGetResponse, error) {
 	return ob.grpcClient.BatchObjects(ctx, ob.objects, ob.consistencyLevel)
 }
+
+// assignIDs derives the IDs of objects without one from their properties. It sets the
+// IDs on the objects of the caller, so that they can refer to the stored objects.
+func assignIDs(objects []*models.Object, keys []string) {
+	for _, obj := range objects {
+		if obj != nil && obj.ID == "" {
+			obj.ID = util.GenerateUUID5FromProperties(propertiesMap(obj.Properties), keys...)
+		}
+	}
+}
+
+// propertiesMap returns the properties of an object as a map, converting structs
+// through their JSON encoding. Numbers are decoded as json.Number, so that an int
+// field hashes like the int of a map.
+func propertiesMap(properties models.PropertySchema) map[string]any {
+	if m, ok := properties.(map[string]any); ok {
+		return m
+	}
+	var m map[string]any
+	if data, err := json.Marshal(properties); err == nil {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		decoder.Decode(&m)
+	}
+	return m
+}
@@ -0,0 +1,30 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/util"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestAssignIDs(t *testing.T) {
+	type article struct {
+		Title string  `json:"title"`
+		Count int     `json:"count"`
+		Score float64 `json:"score"`
+	}
+	fromStruct := &models.Object{Properties: article{Title: "A", Count: 1, Score: 0.5}}
+	fromMap := &models.Object{Properties: map[string]any{"title": "A", "count": 1, "score": 0.5}}
+	withID := &models.Object{ID: "00000000-0000-0000-0000-000000000001", Properties: map[string]any{"title": "A"}}
+
+	assignIDs([]*models.Object{fromStruct, fromMap, withID}, []string{})
+	assert.Equal(t, util.GenerateUUID5(map[string]any{"title": "A", "count": 1, "score": 0.5}), fromMap.ID)
+	assert.Equal(t, fromMap.ID, fromStruct.ID)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", withID.ID.String())
+
+	fromStruct.ID, fromMap.ID = "", ""
+	assignIDs([]*models.Object{fromStruct, fromMap}, []string{"count"})
+	assert.Equal(t, util.GenerateUUID5(1), fromStruct.ID)
+	assert.Equal(t, fromMap.ID, fromStruct.ID)
+}
@@ -5,15 +5,23 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/grpc/common"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ObjectsBatchDeleter struct {
 	connection       *connection.Connection
+	grpcClient       *connection.GrpcClient
 	className        string
 	dryRun           *bool
 	output           *string
@@ -58,10 +66,28 @@ func (b *ObjectsBatchDeleter) WithTenant(tenant string) *ObjectsBatchDeleter {
 
 // Do delete's all the objects which match the builder's filter
 func (ob *ObjectsBatchDeleter) Do(ctx context.Context) (*models.BatchDeleteResponse, error) {
+	ctx, op := ob.connection.Telemetry().Start(ctx, "batch.ObjectsBatchDeleter",
+		telemetry.Collection(ob.className), telemetry.Tenant(ob.tenant), telemetry.ConsistencyLevel(ob.consistencyLevel))
+	result, err := ob.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (ob *ObjectsBatchDeleter) do(ctx context.Context) (*models.BatchDeleteResponse, error) {
 	if ob.whereFilter == nil {
 		return nil, fmt.Errorf("filter must be set prior to deletion, use WithWhere")
 	}
+	if ob.grpcClient != nil {
+		result, err := ob.runGRPC(ctx)
+		if status.Code(err) != codes.Unimplemented {
+			return result, err
+		}
+		// the server does not offer the BatchDelete RPC
+	}
+	return ob.runREST(ctx)
+}
 
+func (ob *ObjectsBatchDeleter) runREST(ctx context.Context) (*models.BatchDeleteResponse, error) {
 	body := &models.BatchDelete{
 		DryRun: ob.dryRun,
 		Output: ob.output,
@@ -85,3 +111,62 @@ func (ob *ObjectsBatchDeleter) Do(ctx context.Context) (*models.BatchDeleteRespo
 	parseErr := responseData.DecodeBodyIntoTarget(&parsedResponse)
 	return &parsedResponse, parseErr
 }
+
+func (ob *ObjectsBatchDeleter) runGRPC(ctx context.Context) (*models.BatchDeleteResponse, error) {
+	req := &pb.BatchDeleteRequest{
+		Collection:       ob.className,
+		Filters:          ob.whereFilter.ToGRPC(),
+		Verbose:          ob.output != nil && *ob.output == "verbose",
+		DryRun:           ob.dryRun != nil && *ob.dryRun,
+		ConsistencyLevel: common.GetConsistencyLevel(ob.consistencyLevel),
+	}
+	if ob.tenant != "" {
+		req.Tenant = &ob.tenant
+	}
+	reply, err := ob.grpcClient.BatchDelete(ctx, req)
+	if err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	return ob.parseReply(reply, req.DryRun, req.Verbose)
+}
+
+// parseReply maps the gRPC reply to the response of the REST API
+func (ob *ObjectsBatchDeleter) parseReply(reply *pb.BatchDeleteReply, dryRun, verbose bool) (*models.BatchDeleteResponse, error) {
+	output := "minimal"
+	if verbose {
+		output = "verbose"
+	}
+	response := &models.BatchDeleteResponse{
+		DryRun: &dryRun,
+		Output: &output,
+		Match: &models.BatchDeleteResponseMatch{
+			Class: ob.className,
+			Where: ob.whereFilter.Build(),
+		},
+		Results: &models.BatchDeleteResponseResults{
+			Failed:     reply.GetFailed(),
+			Matches:    reply.GetMatches(),
+			Successful: reply.GetSuccessful(),
+		},
+	}
+	for _, obj := range reply.GetObjects() {
+		id, err := uuid.FromBytes(obj.GetUuid())
+		if err != nil {
+			return nil, except.NewDerivedWeaviateClientError(fmt.Errorf("parse batch delete reply: %w", err))
+		}
+		item := &models.BatchDeleteResponseResultsObjectsItems0{ID: strfmt.UUID(id.String())}
+		itemStatus := models.BatchDeleteResponseResultsObjectsItems0StatusSUCCESS
+		switch {
+		case obj.GetError() != "":
+			itemStatus = models.BatchDeleteResponseResultsObjectsItems0StatusFAILED
+			item.Errors = &models.ErrorResponse{Error: []*models.ErrorResponseErrorItems0{{Message: obj.GetError()}}}
+		case dryRun:
+			itemStatus = models.BatchDeleteResponseResultsObjectsItems0StatusDRYRUN
+		case !obj.GetSuccessful():
+			itemStatus = models.BatchDeleteResponseResultsObjectsItems0StatusFAILED
+		}
+		item.Status = &itemStatus
+		response.Results.Objects = append(response.Results.Objects, item)
+	}
+	return response, nil
+}
@@ -6,13 +6,18 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	grpcbatch "github.com/weaviate/weaviate-go-client/v5/weaviate/grpc/batch"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // ReferencesBatcher builder to add multiple references in one batch request
 type ReferencesBatcher struct {
 	connection       *connection.Connection
+	grpcClient       *connection.GrpcClient
 	references       []*models.BatchReference
 	consistencyLevel string
 }
@@ -40,6 +45,26 @@ func (rb *ReferencesBatcher) WithConsistencyLevel(cl string) *ReferencesBatcher
 
 // Do add all the references in the batch to weaviate
 func (rb *ReferencesBatcher) Do(ctx context.Context) ([]models.BatchReferenceResponse, error) {
+	ctx, op := rb.connection.Telemetry().Start(ctx, "batch.ReferencesBatcher",
+		telemetry.ObjectCount(len(rb.references)), telemetry.ConsistencyLevel(rb.consistencyLevel))
+	rb.connection.Telemetry().RecordBatchSize(ctx, len(rb.references))
+	result, err := rb.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (rb *ReferencesBatcher) do(ctx context.Context) ([]models.BatchReferenceResponse, error) {
+	if rb.grpcClient != nil && rb.hasLongFormSources() {
+		result, err := rb.runGRPC(ctx)
+		if status.Code(err) != codes.Unimplemented {
+			return result, err
+		}
+		// the server does not offer the BatchReferences RPC
+	}
+	return rb.runREST(ctx)
+}
+
+func (rb *ReferencesBatcher) runREST(ctx context.Context) ([]models.BatchReferenceResponse, error) {
 	path := pathbuilder.BatchReferences(pathbuilder.Components{
 		ConsistencyLevel: rb.consistencyLevel,
 	})
@@ -52,3 +77,25 @@ func (rb *ReferencesBatcher) Do(ctx context.Context) ([]models.BatchReferenceRes
 	decodeErr := responseData.DecodeBodyIntoTarget(&batchResponse)
 	return batchResponse, decodeErr
 }
+
+// hasLongFormSources reports whether the source of every reference names its collection,
+// which is required by the gRPC API
+func (rb *ReferencesBatcher) hasLongFormSources() bool {
+	for _, ref := range rb.references {
+		if ref == nil {
+			return false
+		}
+		if _, err := grpcbatch.Beacon(ref); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (rb *ReferencesBatcher) runGRPC(ctx context.Context) ([]models.BatchReferenceResponse, error) {
+	result, err := rb.grpcClient.BatchReferences(ctx, rb.references, rb.consistencyLevel)
+	if err != nil {
+		return nil, except.NewDerivedWeaviateClientError(err)
+	}
+	return result, nil
+}
@@ -0,0 +1,145 @@
+package batch
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ErrorCategory classifies why an object of a batch failed
+type ErrorCategory string
+
+const (
+	// ErrorCategoryValidation means the object does not match the schema, retrying it fails again.
+	ErrorCategoryValidation ErrorCategory = "validation"
+	// ErrorCategoryRateLimit means the vectorizer rejected the object because of its rate limit.
+	ErrorCategoryRateLimit ErrorCategory = "rate_limit"
+	// ErrorCategoryQuotaExceeded means the vectorizer rejected the object because the quota of
+	// the account is used up, retrying it fails until the quota is raised.
+	ErrorCategoryQuotaExceeded ErrorCategory = "quota_exceeded"
+	// ErrorCategoryTenantInactive means the tenant of the object is not active.
+	ErrorCategoryTenantInactive ErrorCategory = "tenant_inactive"
+	// ErrorCategoryUnknown is any other error, e.g. a timeout on the server.
+	ErrorCategoryUnknown ErrorCategory = "unknown"
+)
+
+var errorCategoryPatterns = []struct {
+	category ErrorCategory
+	patterns []string
+}{
+	{ErrorCategoryTenantInactive, []string{"tenant not active", "is not active", "tenant is inactive", "offloaded"}},
+	{ErrorCategoryQuotaExceeded, []string{"quota"}},
+	{ErrorCategoryRateLimit, []string{"rate limit", "too many requests"}},
+	{ErrorCategoryValidation, []string{"invalid", "validat", "no such prop", "not found in schema", "wrong type", "could not find class"}},
+}
+
+// ClassifyError returns the category of the error message of a failed object.
+func ClassifyError(message string) ErrorCategory {
+	message = strings.ToLower(message)
+	for _, c := range errorCategoryPatterns {
+		for _, pattern := range c.patterns {
+			if strings.Contains(message, pattern) {
+				return c.category
+			}
+		}
+	}
+	return ErrorCategoryUnknown
+}
+
+// Retryable reports whether an object failing with this category may succeed when it is sent again.
+// Objects of inactive tenants are not retryable, as the tenant needs to be activated first,
+// neither are objects rejected because of an exceeded quota.
+func (c ErrorCategory) Retryable() bool {
+	return c == ErrorCategoryRateLimit || c == ErrorCategoryUnknown
+}
+
+// FailedObject is an object of a batch which could not be stored
+type FailedObject struct {
+	Object *models.Object
+	// Index of the object in the batch
+	Index    int
+	Category ErrorCategory
+	Err      error
+}
+
+// ObjectsBatchResult is the typed result of a batch of objects
+type ObjectsBatchResult struct {
+	// Succeeded holds the IDs of the stored objects
+	Succeeded []strfmt.UUID
+	// Failed holds the objects which could not be stored, in the order of the batch
+	Failed []FailedObject
+}
+
+// NewObjectsBatchResult classifies the responses of a batch of objects. The responses
+// must be in the order of objects, as returned by ObjectsBatcher.Do.
+func NewObjectsBatchResult(objects []*models.Object, responses []models.ObjectsGetResponse) *ObjectsBatchResult {
+	result := &ObjectsBatchResult{}
+	for i, response := range responses {
+		object := &response.Object
+		if i < len(objects) {
+			object = objects[i]
+		}
+		if response.Result == nil || !isFailed(response.Result.Errors, response.Result.Status) {
+			result.Succeeded = append(result.Succeeded, response.Object.ID)
+			continue
+		}
+		err := responseError(response.Result.Errors)
+		result.Failed = append(result.Failed, FailedObject{
+			Object:   object,
+			Index:    i,
+			Category: ClassifyError(err.Error()),
+			Err:      err,
+		})
+	}
+	return result
+}
+
+// HasErrors reports whether any object of the batch failed.
+func (r *ObjectsBatchResult) HasErrors() bool {
+	return len(r.Failed) > 0
+}
+
+// FailedWith returns the failed objects of the given category.
+func (r *ObjectsBatchResult) FailedWith(category ErrorCategory) []FailedObject {
+	var failed []FailedObject
+	for _, f := range r.Failed {
+		if f.Category == category {
+			failed = append(failed, f)
+		}
+	}
+	return failed
+}
+
+// RetryableObjects returns the failed objects which may succeed when they are sent again,
+// to build a retry batch with ObjectsBatcher.WithObjects.
+func (r *ObjectsBatchResult) RetryableObjects() []*models.Object {
+	var objects []*models.Object
+	for _, f := range r.Failed {
+		if f.Category.Retryable() {
+			objects = append(objects, f.Object)
+		}
+	}
+	return objects
+}
+
+func isFailed(errorResponse *models.ErrorResponse, status *string) bool {
+	return errorResponse != nil || (status != nil && *status == models.ObjectsGetResponseAO2ResultStatusFAILED)
+}
+
+func responseError(errorResponse *models.ErrorResponse) error {
+	if errorResponse == nil {
+		return errors.New("batch item failed")
+	}
+	var errs []error
+	for _, item := range errorResponse.Error {
+		if item != nil {
+			errs = append(errs, errors.New(item.Message))
+		}
+	}
+	if len(errs) == 0 {
+		return errors.New("batch item failed")
+	}
+	return errors.Join(errs...)
+}
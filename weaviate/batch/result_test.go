@@ -0,0 +1,68 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		message  string
+		category ErrorCategory
+	}{
+		{"invalid object property 'title' on class 'Article': not a string", ErrorCategoryValidation},
+		{"no such prop with name 'foo' found in class 'Article' in the schema", ErrorCategoryValidation},
+		{"update vector: connection to: OpenAI API failed with status: 429 error: Rate limit reached", ErrorCategoryRateLimit},
+		{"request rate limit exceeded and will not refresh in time", ErrorCategoryRateLimit},
+		{"connection to: OpenAI API failed with status: 429 error: You exceeded your current quota", ErrorCategoryQuotaExceeded},
+		{`tenant not active: "tenantA"`, ErrorCategoryTenantInactive},
+		{"context deadline exceeded", ErrorCategoryUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			assert.Equal(t, tt.category, ClassifyError(tt.message))
+		})
+	}
+}
+
+func TestErrorCategory_Retryable(t *testing.T) {
+	assert.True(t, ErrorCategoryRateLimit.Retryable())
+	assert.True(t, ErrorCategoryUnknown.Retryable())
+	assert.False(t, ErrorCategoryQuotaExceeded.Retryable())
+	assert.False(t, ErrorCategoryTenantInactive.Retryable())
+	assert.False(t, ErrorCategoryValidation.Retryable())
+}
+
+func TestNewObjectsBatchResult(t *testing.T) {
+	failed := func(message string) *models.ObjectsGetResponseAO2Result {
+		return &models.ObjectsGetResponseAO2Result{Errors: &models.ErrorResponse{
+			Error: []*models.ErrorResponseErrorItems0{{Message: message}},
+		}}
+	}
+	objects := []*models.Object{
+		{ID: "00000000-0000-0000-0000-000000000001"},
+		{ID: "00000000-0000-0000-0000-000000000002"},
+		{ID: "00000000-0000-0000-0000-000000000003"},
+		{ID: "00000000-0000-0000-0000-000000000004"},
+	}
+	responses := []models.ObjectsGetResponse{
+		{Object: *objects[0]},
+		{Object: *objects[1], Result: failed("invalid object property 'title'")},
+		{Object: *objects[2], Result: failed("rate limit reached")},
+		{Object: *objects[3], Result: failed("tenant not active")},
+	}
+
+	result := NewObjectsBatchResult(objects, responses)
+	assert.True(t, result.HasErrors())
+	assert.Equal(t, []strfmt.UUID{objects[0].ID}, result.Succeeded)
+	require.Len(t, result.Failed, 3)
+	assert.Same(t, objects[1], result.Failed[0].Object)
+	assert.Equal(t, 1, result.Failed[0].Index)
+	assert.EqualError(t, result.Failed[0].Err, "invalid object property 'title'")
+	assert.Len(t, result.FailedWith(ErrorCategoryTenantInactive), 1)
+	assert.Equal(t, []*models.Object{objects[2]}, result.RetryableObjects())
+}
@@ -0,0 +1,494 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	grpcbatch "github.com/weaviate/weaviate-go-client/v5/weaviate/grpc/batch"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/util"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+const (
+	defaultStreamBatchSize   = 100
+	defaultStreamMaxInFlight = 10_000
+	maxStreamReconnects      = 5
+)
+
+// ErrStreamClosed is returned when adding to a stream which has been closed.
+var ErrStreamClosed = errors.New("batch stream is closed")
+
+// StreamResult is the result of an object or a reference sent through a Stream.
+// Exactly one of Object and Reference is set.
+type StreamResult struct {
+	Object    *models.Object
+	Reference *models.BatchReference
+	// Err is nil if the object or reference has been stored.
+	Err error
+}
+
+// StreamBatcher builder to open a stream which sends objects and references to
+// Weaviate as they are produced, using the BatchStream gRPC API.
+type StreamBatcher struct {
+	grpcClient       *connection.GrpcClient
+	consistencyLevel string
+	maxInFlight      int
+	onResult         func(StreamResult)
+	idKeys           []string
+}
+
+// WithConsistencyLevel determines how many replicas must acknowledge a request
+// before it is considered successful. Can be one of 'ALL', 'ONE', or 'QUORUM'.
+func (sb *StreamBatcher) WithConsistencyLevel(cl string) *StreamBatcher {
+	sb.consistencyLevel = cl
+	return sb
+}
+
+// WithMaxInFlight limits the number of objects and references which have been added
+// but have no result yet. Adding blocks while the limit is reached. Defaults to 10000.
+func (sb *StreamBatcher) WithMaxInFlight(maxInFlight int) *StreamBatcher {
+	sb.maxInFlight = maxInFlight
+	return sb
+}
+
+// WithResultHandler sets the function called with the result of every object and reference.
+// Calls never overlap, but may come from different goroutines. The function must not block
+// for long, as it holds up the stream.
+func (sb *StreamBatcher) WithResultHandler(onResult func(StreamResult)) *StreamBatcher {
+	sb.onResult = onResult
+	return sb
+}
+
+// WithDeterministicIDs gives objects without an ID one derived from the values of the
+// given property keys, or of all properties if no key is given, instead of a random one.
+func (sb *StreamBatcher) WithDeterministicIDs(keys ...string) *StreamBatcher {
+	sb.idKeys = keys
+	if sb.idKeys == nil {
+		sb.idKeys = []string{}
+	}
+	return sb
+}
+
+// Start opens the stream. The stream must be closed with Stream.Close once everything
+// has been added.
+func (sb *StreamBatcher) Start(ctx context.Context) (*Stream, error) {
+	if sb.grpcClient == nil {
+		return nil, errors.New("batch stream: a gRPC connection is required, set GrpcConfig in the client config")
+	}
+	maxInFlight := sb.maxInFlight
+	if maxInFlight <= 0 {
+		maxInFlight = defaultStreamMaxInFlight
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	bs, err := sb.grpcClient.OpenBatchStream(ctx, sb.consistencyLevel)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	s := &Stream{
+		batcher:      sb,
+		ctx:          ctx,
+		cancel:       cancel,
+		queue:        make(chan *streamItem, defaultStreamBatchSize),
+		slots:        make(chan struct{}, maxInFlight),
+		pending:      map[string][]*streamItem{},
+		senderDone:   make(chan struct{}),
+		receiverDone: make(chan struct{}),
+	}
+	s.stream.Store(bs)
+	s.batchSize.Store(defaultStreamBatchSize)
+	go s.sender()
+	go s.receiver()
+	return s, nil
+}
+
+// streamItem is an object or a reference sent through a Stream
+type streamItem struct {
+	// key identifies the item in replies, the UUID of an object or the beacon of a reference
+	key       string
+	object    *models.Object
+	reference *models.BatchReference
+}
+
+// Stream sends objects and references to Weaviate over one long-lived gRPC stream.
+// The size of the messages follows the server's backoff messages, and sending is paused
+// and resumed on a new stream when the server runs out of memory or shuts down.
+type Stream struct {
+	batcher *StreamBatcher
+	ctx     context.Context
+	cancel  context.CancelFunc
+
+	queue     chan *streamItem
+	slots     chan struct{}
+	batchSize atomic.Int32
+
+	addMutex sync.RWMutex
+	closed   bool
+
+	// stream is read without a lock, so that receiving never waits for a blocked send
+	stream atomic.Pointer[connection.BatchStream]
+	// sendMutex serializes sending on the stream, replacing and stopping it
+	sendMutex sync.Mutex
+	stopped   bool
+	// resends is the number of reconnects still resending their pending items
+	resends int
+
+	// resultMutex serializes calls to the result handler
+	resultMutex sync.Mutex
+
+	mutex   sync.Mutex
+	pending map[string][]*streamItem
+	// reconnectAfter is set when the server announced that the stream will end,
+	// the stream is then reopened after the given wait time
+	reconnectAfter *time.Duration
+	finished       bool
+	err            error
+
+	senderDone   chan struct{}
+	receiverDone chan struct{}
+}
+
+// AddObjects sends objects to Weaviate. Objects without an ID are given a random one,
+// or one derived from their properties, see StreamBatcher.WithDeterministicIDs.
+// It blocks while the maximum number of objects in flight is reached.
+func (s *Stream) AddObjects(ctx context.Context, objects ...*models.Object) error {
+	items := make([]*streamItem, len(objects))
+	for i, obj := range objects {
+		if obj == nil {
+			return fmt.Errorf("object at index %d is nil", i)
+		}
+		object := *obj
+		if object.ID == "" && s.batcher.idKeys != nil {
+			object.ID = util.GenerateUUID5FromProperties(propertiesMap(object.Properties), s.batcher.idKeys...)
+		}
+		if object.ID == "" {
+			object.ID = strfmt.UUID(uuid.NewString())
+		}
+		items[i] = &streamItem{key: object.ID.String(), object: &object}
+	}
+	return s.add(ctx, items)
+}
+
+// AddReferences sends references to Weaviate. The source of every reference must be
+// a long-form beacon including the collection, e.g. weaviate://localhost/Article/<uuid>/hasAuthor.
+// It blocks while the maximum number of references in flight is reached.
+func (s *Stream) AddReferences(ctx context.Context, references ...*models.BatchReference) error {
+	items := make([]*streamItem, len(references))
+	for i, ref := range references {
+		if ref == nil {
+			return fmt.Errorf("reference at index %d is nil", i)
+		}
+		beacon, err := grpcbatch.Beacon(ref)
+		if err != nil {
+			return fmt.Errorf("reference at index %d: %w", i, err)
+		}
+		items[i] = &streamItem{key: beacon, reference: ref}
+	}
+	return s.add(ctx, items)
+}
+
+func (s *Stream) add(ctx context.Context, items []*streamItem) error {
+	s.addMutex.RLock()
+	defer s.addMutex.RUnlock()
+	if s.closed {
+		return ErrStreamClosed
+	}
+	for _, item := range items {
+		select {
+		case s.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.receiverDone:
+			if err := s.Err(); err != nil {
+				return err
+			}
+			return ErrStreamClosed
+		}
+		select {
+		case s.queue <- item:
+		case <-ctx.Done():
+			<-s.slots
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close sends everything added so far, waits for all results and closes the stream.
+// It returns the error which ended the stream early, if any.
+func (s *Stream) Close() error {
+	s.addMutex.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.addMutex.Unlock()
+
+	<-s.senderDone
+	<-s.receiverDone
+	s.cancel()
+	return s.Err()
+}
+
+// Err returns the error which ended the stream early, if any.
+func (s *Stream) Err() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.err
+}
+
+// sender gathers the added items into messages of the current batch size
+func (s *Stream) sender() {
+	defer close(s.senderDone)
+	for item := range s.queue {
+		items := []*streamItem{item}
+	gather:
+		for len(items) < int(s.batchSize.Load()) {
+			select {
+			case next, ok := <-s.queue:
+				if !ok {
+					break gather
+				}
+				items = append(items, next)
+			default:
+				break gather
+			}
+		}
+		s.send(items)
+	}
+
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+	s.stopped = true
+	if s.resends == 0 {
+		// otherwise the last resend stops the stream
+		s.stop()
+	}
+}
+
+// stop tells the server that no more data will be sent, the caller must hold the sendMutex
+func (s *Stream) stop() {
+	if err := s.stream.Load().Stop(); err != nil {
+		s.batcher.grpcClient.Logger().Warn("failed to stop batch stream", "error", err)
+	}
+}
+
+func (s *Stream) send(items []*streamItem) {
+	s.mutex.Lock()
+	if s.finished {
+		s.mutex.Unlock()
+		s.deliver(items, ErrStreamClosed)
+		return
+	}
+	for _, item := range items {
+		s.pending[item.key] = append(s.pending[item.key], item)
+	}
+	s.mutex.Unlock()
+
+	s.batcher.grpcClient.Telemetry().RecordBatchSize(s.ctx, len(items))
+	s.sendMutex.Lock()
+	err := s.stream.Load().Send(split(items))
+	s.sendMutex.Unlock()
+	if err != nil && !errors.Is(err, io.EOF) {
+		// the items could not be encoded, transport errors are reported by Recv
+		s.mutex.Lock()
+		for _, item := range items {
+			s.popPending(item.key)
+		}
+		s.mutex.Unlock()
+		s.deliver(items, err)
+	}
+}
+
+// receiver handles the replies of the server until the stream ends
+func (s *Stream) receiver() {
+	defer close(s.receiverDone)
+	for {
+		reply, err := s.stream.Load().Recv()
+		if err != nil {
+			if s.reconnect() {
+				continue
+			}
+			s.finish(err)
+			return
+		}
+		switch message := reply.Message.(type) {
+		case *pb.BatchStreamReply_Results_:
+			s.handleResults(message.Results)
+		case *pb.BatchStreamReply_Backoff_:
+			s.batchSize.Store(max(1, message.Backoff.GetBatchSize()))
+		case *pb.BatchStreamReply_OutOfMemory_:
+			s.setReconnectAfter(time.Duration(message.OutOfMemory.GetWaitTime()) * time.Second)
+		case *pb.BatchStreamReply_ShuttingDown_:
+			s.setReconnectAfter(0)
+		}
+	}
+}
+
+func (s *Stream) handleResults(results *pb.BatchStreamReply_Results) {
+	var delivered []StreamResult
+	s.mutex.Lock()
+	for _, res := range results.GetErrors() {
+		if item := s.popPending(res.GetUuid() + res.GetBeacon()); item != nil {
+			delivered = append(delivered, item.result(errors.New(res.GetError())))
+		}
+	}
+	for _, res := range results.GetSuccesses() {
+		if item := s.popPending(res.GetUuid() + res.GetBeacon()); item != nil {
+			delivered = append(delivered, item.result(nil))
+		}
+	}
+	s.mutex.Unlock()
+	for _, result := range delivered {
+		s.report(result)
+	}
+}
+
+func (s *Stream) setReconnectAfter(wait time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.reconnectAfter = &wait
+}
+
+// reconnect reopens the stream if the server announced its end, and resends
+// every item without a result. It returns false if the stream is over.
+func (s *Stream) reconnect() bool {
+	s.mutex.Lock()
+	wait := s.reconnectAfter
+	s.reconnectAfter = nil
+	s.mutex.Unlock()
+	if wait == nil || s.ctx.Err() != nil {
+		return false
+	}
+
+	s.batcher.grpcClient.Logger().Warn("batch stream ended by the server, reconnecting", "wait", *wait)
+	for attempt := 1; attempt <= maxStreamReconnects; attempt++ {
+		select {
+		case <-s.ctx.Done():
+			return false
+		case <-time.After(*wait):
+		}
+		stream, err := s.batcher.grpcClient.OpenBatchStream(s.ctx, s.batcher.consistencyLevel)
+		if err != nil {
+			s.batcher.grpcClient.Logger().Warn("failed to reopen batch stream", "attempt", attempt, "error", err)
+			*wait = time.Duration(attempt) * time.Second
+			continue
+		}
+
+		s.sendMutex.Lock()
+		s.stream.Store(stream)
+		s.mutex.Lock()
+		var items []*streamItem
+		for _, pending := range s.pending {
+			items = append(items, pending...)
+		}
+		s.mutex.Unlock()
+		s.resends++
+		s.sendMutex.Unlock()
+		// the items are resent while the receiver handles the replies of the new stream,
+		// otherwise flow control may block sending
+		go s.resend(stream, items)
+		return true
+	}
+	return false
+}
+
+// resend sends items on stream, and stops the stream if the sender is done
+func (s *Stream) resend(stream *connection.BatchStream, items []*streamItem) {
+	batchSize := int(s.batchSize.Load())
+	for start := 0; start < len(items); start += batchSize {
+		s.sendMutex.Lock()
+		err := stream.Send(split(items[start:min(start+batchSize, len(items))]))
+		s.sendMutex.Unlock()
+		if err != nil {
+			// the new stream failed as well, Recv reports why
+			break
+		}
+	}
+
+	s.sendMutex.Lock()
+	defer s.sendMutex.Unlock()
+	s.resends--
+	if s.stopped && s.resends == 0 {
+		s.stop()
+	}
+}
+
+// finish ends the stream, every item without a result fails
+func (s *Stream) finish(err error) {
+	s.mutex.Lock()
+	s.finished = true
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+	if err != nil {
+		s.err = fmt.Errorf("batch stream: %w", err)
+	}
+	itemErr := s.err
+	if itemErr == nil {
+		itemErr = errors.New("batch stream: closed by the server before a result was received")
+	}
+	var items []*streamItem
+	for _, pending := range s.pending {
+		items = append(items, pending...)
+	}
+	clear(s.pending)
+	s.mutex.Unlock()
+	s.deliver(items, itemErr)
+}
+
+// popPending removes the oldest pending item with key, the caller must hold the mutex
+func (s *Stream) popPending(key string) *streamItem {
+	pending := s.pending[key]
+	if len(pending) == 0 {
+		return nil
+	}
+	if len(pending) == 1 {
+		delete(s.pending, key)
+	} else {
+		s.pending[key] = pending[1:]
+	}
+	return pending[0]
+}
+
+func (s *Stream) deliver(items []*streamItem, err error) {
+	for _, item := range items {
+		s.report(item.result(err))
+	}
+}
+
+func (s *Stream) report(result StreamResult) {
+	if s.batcher.onResult != nil {
+		s.resultMutex.Lock()
+		s.batcher.onResult(result)
+		s.resultMutex.Unlock()
+	}
+	<-s.slots
+}
+
+func (item *streamItem) result(err error) StreamResult {
+	return StreamResult{Object: item.object, Reference: item.reference, Err: err}
+}
+
+// split returns the objects and references of items
+func split(items []*streamItem) ([]*models.Object, []*models.BatchReference) {
+	var objects []*models.Object
+	var references []*models.BatchReference
+	for _, item := range items {
+		if item.object != nil {
+			objects = append(objects, item.object)
+		} else {
+			references = append(references, item.reference)
+		}
+	}
+	return objects, references
+}
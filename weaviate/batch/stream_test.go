@@ -0,0 +1,245 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeBatchServer answers every object with a result, failing the objects of class "Invalid".
+// The first `shutdowns` streams are ended with a shutting down message after the first data message.
+type fakeBatchServer struct {
+	pb.UnimplementedWeaviateServer
+	shutdowns atomic.Int32
+	streams   atomic.Int32
+}
+
+func (f *fakeBatchServer) BatchStream(stream grpc.BidiStreamingServer[pb.BatchStreamRequest, pb.BatchStreamReply]) error {
+	f.streams.Add(1)
+	if req, err := stream.Recv(); err != nil || req.GetStart() == nil {
+		return errors.New("expected start message")
+	}
+	if err := stream.Send(&pb.BatchStreamReply{Message: &pb.BatchStreamReply_Started_{Started: &pb.BatchStreamReply_Started{}}}); err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.BatchStreamReply{Message: &pb.BatchStreamReply_Backoff_{Backoff: &pb.BatchStreamReply_Backoff{BatchSize: 2}}}); err != nil {
+		return err
+	}
+	return f.serve(stream)
+}
+
+// serve answers the data messages of stream until it is stopped
+func (f *fakeBatchServer) serve(stream grpc.BidiStreamingServer[pb.BatchStreamRequest, pb.BatchStreamReply]) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) || req.GetStop() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if f.shutdowns.Add(-1) >= 0 {
+			return stream.Send(&pb.BatchStreamReply{Message: &pb.BatchStreamReply_ShuttingDown_{ShuttingDown: &pb.BatchStreamReply_ShuttingDown{}}})
+		}
+		results := &pb.BatchStreamReply_Results{}
+		for _, obj := range req.GetData().GetObjects().GetValues() {
+			if obj.Collection == "Invalid" {
+				results.Errors = append(results.Errors, &pb.BatchStreamReply_Results_Error{
+					Error: "invalid", Detail: &pb.BatchStreamReply_Results_Error_Uuid{Uuid: obj.Uuid},
+				})
+				continue
+			}
+			results.Successes = append(results.Successes, &pb.BatchStreamReply_Results_Success{
+				Detail: &pb.BatchStreamReply_Results_Success_Uuid{Uuid: obj.Uuid},
+			})
+		}
+		for _, ref := range req.GetData().GetReferences().GetValues() {
+			results.Successes = append(results.Successes, &pb.BatchStreamReply_Results_Success{
+				Detail: &pb.BatchStreamReply_Results_Success_Beacon{
+					Beacon: "weaviate://localhost/" + ref.FromCollection + "/" + ref.FromUuid + "/" + ref.Name,
+				},
+			})
+		}
+		if err := stream.Send(&pb.BatchStreamReply{Message: &pb.BatchStreamReply_Results_{Results: results}}); err != nil {
+			return err
+		}
+	}
+}
+
+// newTestGrpcClient returns a client connected to an in-process gRPC server
+func newTestGrpcClient(t *testing.T, server pb.WeaviateServer) *connection.GrpcClient {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	pb.RegisterWeaviateServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	grpcClient, err := connection.NewGrpcClient(listener.Addr().String(), false, nil, nil, time.Second, 0, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { grpcClient.Close() })
+	return grpcClient
+}
+
+// floodingBatchServer sends many large replies before it reads any data, and then
+// answers like fakeBatchServer
+type floodingBatchServer struct {
+	fakeBatchServer
+}
+
+func (f *floodingBatchServer) BatchStream(stream grpc.BidiStreamingServer[pb.BatchStreamRequest, pb.BatchStreamReply]) error {
+	if req, err := stream.Recv(); err != nil || req.GetStart() == nil {
+		return errors.New("expected start message")
+	}
+	if err := stream.Send(&pb.BatchStreamReply{Message: &pb.BatchStreamReply_Started_{Started: &pb.BatchStreamReply_Started{}}}); err != nil {
+		return err
+	}
+	unknown := &pb.BatchStreamReply_Results{Errors: []*pb.BatchStreamReply_Results_Error{{
+		Error:  strings.Repeat("x", 1<<16),
+		Detail: &pb.BatchStreamReply_Results_Error_Uuid{Uuid: "unknown"},
+	}}}
+	for range 200 {
+		if err := stream.Send(&pb.BatchStreamReply{Message: &pb.BatchStreamReply_Results_{Results: unknown}}); err != nil {
+			return err
+		}
+	}
+	return f.serve(stream)
+}
+
+func newTestStreamBatcher(t *testing.T, server pb.WeaviateServer) (*StreamBatcher, *[]StreamResult) {
+	t.Helper()
+	grpcClient := newTestGrpcClient(t, server)
+
+	var mutex sync.Mutex
+	var results []StreamResult
+	batcher := New(nil, grpcClient, nil).StreamBatcher().WithResultHandler(func(result StreamResult) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		results = append(results, result)
+	})
+	return batcher, &results
+}
+
+func newObject(class string) *models.Object {
+	return &models.Object{Class: class, Properties: map[string]interface{}{"title": "title"}}
+}
+
+func TestStream(t *testing.T) {
+	t.Run("reports the result of every object and reference", func(t *testing.T) {
+		batcher, results := newTestStreamBatcher(t, &fakeBatchServer{})
+		stream, err := batcher.Start(context.Background())
+		require.NoError(t, err)
+
+		require.NoError(t, stream.AddObjects(context.Background(),
+			newObject("Article"), newObject("Invalid"), newObject("Article")))
+		require.NoError(t, stream.AddReferences(context.Background(), &models.BatchReference{
+			From: "weaviate://localhost/Article/00000000-0000-0000-0000-000000000001/hasAuthor",
+			To:   "weaviate://localhost/Author/00000000-0000-0000-0000-000000000002",
+		}))
+		require.NoError(t, stream.Close())
+
+		require.Len(t, *results, 4)
+		var failed int
+		for _, result := range *results {
+			if result.Err != nil {
+				failed++
+				require.NotNil(t, result.Object)
+				assert.Equal(t, "Invalid", result.Object.Class)
+				assert.NotEmpty(t, result.Object.ID)
+			}
+		}
+		assert.Equal(t, 1, failed)
+		assert.ErrorIs(t, stream.AddObjects(context.Background(), newObject("Article")), ErrStreamClosed)
+	})
+
+	t.Run("resends pending objects when the server shuts down", func(t *testing.T) {
+		server := &fakeBatchServer{}
+		server.shutdowns.Store(1)
+		batcher, results := newTestStreamBatcher(t, server)
+		stream, err := batcher.Start(context.Background())
+		require.NoError(t, err)
+
+		require.NoError(t, stream.AddObjects(context.Background(), newObject("Article"), newObject("Article")))
+		require.NoError(t, stream.Close())
+
+		require.Len(t, *results, 2)
+		for _, result := range *results {
+			assert.NoError(t, result.Err)
+		}
+		assert.Equal(t, int32(2), server.streams.Load())
+	})
+
+	t.Run("never calls the result handler concurrently", func(t *testing.T) {
+		var active, overlaps atomic.Int32
+		var results []StreamResult
+		handling := make(chan struct{}, 1)
+		batcher := New(nil, newTestGrpcClient(t, &fakeBatchServer{}), nil).StreamBatcher().
+			WithResultHandler(func(result StreamResult) {
+				if active.Add(1) > 1 {
+					overlaps.Add(1)
+				}
+				results = append(results, result)
+				if result.Err == nil {
+					handling <- struct{}{}
+					time.Sleep(50 * time.Millisecond)
+				}
+				active.Add(-1)
+			})
+		stream, err := batcher.Start(context.Background())
+		require.NoError(t, err)
+
+		// the receiver reports the stored object, while the sender reports the object
+		// which fails to encode as its properties are not a map
+		require.NoError(t, stream.AddObjects(context.Background(), newObject("Article")))
+		<-handling
+		require.NoError(t, stream.AddObjects(context.Background(), &models.Object{Class: "Article", Properties: "title"}))
+		require.NoError(t, stream.Close())
+
+		require.Len(t, results, 2)
+		assert.NoError(t, results[0].Err)
+		assert.Error(t, results[1].Err)
+		assert.Zero(t, overlaps.Load())
+	})
+
+	t.Run("receives while sending is blocked", func(t *testing.T) {
+		batcher, results := newTestStreamBatcher(t, &floodingBatchServer{})
+		stream, err := batcher.Start(context.Background())
+		require.NoError(t, err)
+
+		closed := make(chan error)
+		go func() {
+			// large objects fill the flow control window, as the server does not read them yet
+			for range 32 {
+				object := &models.Object{Class: "Article", Properties: map[string]any{"title": strings.Repeat("x", 1<<16)}}
+				assert.NoError(t, stream.AddObjects(context.Background(), object))
+			}
+			closed <- stream.Close()
+		}()
+		select {
+		case err := <-closed:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the stream is deadlocked")
+		}
+		assert.Len(t, *results, 32)
+	})
+
+	t.Run("requires a gRPC connection", func(t *testing.T) {
+		_, err := New(nil, nil, nil).StreamBatcher().Start(context.Background())
+		assert.Error(t, err)
+	})
+}
@@ -24,6 +24,13 @@ func (g *Getter) WithID(uuid string) *Getter {
 
 // Do get the classification
 func (g *Getter) Do(ctx context.Context) (*models.Classification, error) {
+	ctx, op := g.connection.Telemetry().Start(ctx, "classifications.Getter")
+	result, err := g.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (g *Getter) do(ctx context.Context) (*models.Classification, error) {
 	path := fmt.Sprintf("/classifications/%v", g.withID)
 	responseData, responseErr := g.connection.RunREST(ctx, path, http.MethodGet, nil)
 	err := except.CheckResponseDataErrorAndStatusCode(responseData, responseErr, 200)
@@ -9,6 +9,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -82,6 +83,13 @@ func (s *Scheduler) WithWaitForCompletion() *Scheduler {
 
 // Do schedule the classification in weaviate
 func (s *Scheduler) Do(ctx context.Context) (*models.Classification, error) {
+	ctx, op := s.connection.Telemetry().Start(ctx, "classifications.Scheduler", telemetry.Collection(s.withClassName))
+	result, err := s.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (s *Scheduler) do(ctx context.Context) (*models.Classification, error) {
 	responseData, responseErr := s.connection.RunREST(ctx, "/classifications", http.MethodPost, s.buildConfig())
 	err := except.CheckResponseDataErrorAndStatusCode(responseData, responseErr, 201)
 	if err != nil {
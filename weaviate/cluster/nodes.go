@@ -6,6 +6,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -17,6 +18,14 @@ type NodesStatusGetter struct {
 
 // Do get the nodes endpoint
 func (nsg *NodesStatusGetter) Do(ctx context.Context) (*models.NodesStatusResponse, error) {
+	ctx, op := nsg.connection.Telemetry().Start(ctx, "cluster.NodesStatusGetter",
+		telemetry.Collection(nsg.class))
+	result, err := nsg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (nsg *NodesStatusGetter) do(ctx context.Context) (*models.NodesStatusResponse, error) {
 	path := "/nodes"
 	if nsg.class != "" {
 		path += "/" + nsg.class
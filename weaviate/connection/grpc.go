@@ -3,45 +3,224 @@ package connection
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/fault"
+	grpcconfig "github.com/weaviate/weaviate-go-client/v5/weaviate/grpc"
 	grpcbatch "github.com/weaviate/weaviate-go-client/v5/weaviate/grpc/batch"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/loadbalance"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/leastrequest"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // enables client side health checking
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
 )
 
 type GrpcClient struct {
-	client  pb.WeaviateClient
-	headers map[string]string
-	timeout time.Duration
-	batch   grpcbatch.Batch
+	conn      *grpc.ClientConn
+	client    pb.WeaviateClient
+	headers   map[string]string
+	timeout   time.Duration
+	batch     grpcbatch.Batch
+	retry     *grpcconfig.RetryConfig
+	telemetry *telemetry.Telemetry
+	logger    *slog.Logger
+
+	mutex    sync.RWMutex
+	closed   bool
+	inFlight sync.WaitGroup
+}
+
+// GrpcOptions configures a GrpcClient created with NewGrpcClientWithOptions.
+type GrpcOptions struct {
+	// Config of the gRPC connection, the host is required.
+	Config *grpcconfig.Config
+	// Headers sent as metadata with every call.
+	Headers            map[string]string
+	GRPCVersionSupport *db.GRPCVersionSupport
+	// Timeout of every unary call, streams are not subject to it.
+	Timeout time.Duration
+	// StartupTimeout of the health check run when the client is created, it is skipped if 0.
+	StartupTimeout time.Duration
+	// LoadBalancing of calls across Config.Hosts, the defaults are used if nil.
+	LoadBalancing *loadbalance.Config
+	// Interceptors wrap every unary call, the first one is the outermost.
+	Interceptors []GrpcInterceptor
 }
 
+// NewGrpcClient creates a client connected to host.
+// See NewGrpcClientWithOptions for retries, load balancing and interceptors.
 func NewGrpcClient(host string, secured bool, headers map[string]string,
 	gRPCVersionSupport *db.GRPCVersionSupport, timeout, startupTimeout time.Duration,
 	keepaliveParams *keepalive.ClientParameters,
 ) (*GrpcClient, error) {
-	client, err := createClient(host, secured, startupTimeout, keepaliveParams)
+	return NewGrpcClientWithOptions(GrpcOptions{
+		Config:             &grpcconfig.Config{Host: host, Secured: secured, Keepalive: keepaliveParams},
+		Headers:            headers,
+		GRPCVersionSupport: gRPCVersionSupport,
+		Timeout:            timeout,
+		StartupTimeout:     startupTimeout,
+	})
+}
+
+// NewGrpcClientWithOptions creates a client as configured by options.
+func NewGrpcClientWithOptions(options GrpcOptions) (*GrpcClient, error) {
+	if options.Config == nil {
+		return nil, errors.New("create grpc client: a config is required")
+	}
+	c := &GrpcClient{
+		headers: options.Headers,
+		timeout: options.Timeout,
+		batch:   grpcbatch.New(options.GRPCVersionSupport),
+		retry:   options.Config.Retry,
+	}
+	// telemetry and logging interceptors run once per attempt, before the user's interceptors
+	interceptors := append([]GrpcInterceptor{c.telemetryInterceptor, c.loggingInterceptor}, options.Interceptors...)
+	// streams are only tracked and traced, as they are neither logged nor retried
+	streamInterceptors := []grpc.StreamClientInterceptor{c.lifecycleStreamInterceptor, c.telemetryStreamInterceptor}
+	conn, err := createClient(options.Config, options.StartupTimeout, options.LoadBalancing,
+		c.lifecycleInterceptor, interceptors, streamInterceptors)
 	if err != nil {
 		return nil, fmt.Errorf("create grpc client: %w", err)
 	}
-	return &GrpcClient{client, headers, timeout, grpcbatch.New(gRPCVersionSupport)}, nil
+	c.conn = conn
+	c.client = pb.NewWeaviateClient(conn)
+	return c, nil
+}
+
+// Close waits for in-flight calls to finish and closes the gRPC connection.
+// Calls made after Close return fault.ErrClientClosed.
+func (c *GrpcClient) Close() error {
+	c.mutex.Lock()
+	if c.closed {
+		c.mutex.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.mutex.Unlock()
+
+	c.inFlight.Wait()
+	return c.conn.Close()
+}
+
+// lifecycleInterceptor rejects calls once the client is closed and tracks in-flight calls otherwise
+func (c *GrpcClient) lifecycleInterceptor(ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	c.mutex.RLock()
+	if c.closed {
+		c.mutex.RUnlock()
+		return fault.ErrClientClosed
+	}
+	c.inFlight.Add(1)
+	c.mutex.RUnlock()
+	defer c.inFlight.Done()
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// lifecycleStreamInterceptor rejects streams once the client is closed and tracks open streams
+// otherwise, so that Close waits until they have ended
+func (c *GrpcClient) lifecycleStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc,
+	cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	c.mutex.RLock()
+	if c.closed {
+		c.mutex.RUnlock()
+		return nil, fault.ErrClientClosed
+	}
+	c.inFlight.Add(1)
+	c.mutex.RUnlock()
+
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		c.inFlight.Done()
+		return nil, err
+	}
+	return onStreamEnd(stream, func(error) { c.inFlight.Done() }), nil
+}
+
+// onStreamEnd returns stream, calling end once when it has ended. That is when
+// receiving fails, io.EOF included, or when the context of the stream is done.
+func onStreamEnd(stream grpc.ClientStream, end func(err error)) grpc.ClientStream {
+	s := &endingStream{ClientStream: stream}
+	var once sync.Once
+	s.end = func(err error) { once.Do(func() { end(err) }) }
+	go func() {
+		<-stream.Context().Done()
+		s.end(stream.Context().Err())
+	}()
+	return s
+}
+
+type endingStream struct {
+	grpc.ClientStream
+	end func(err error)
+}
+
+func (s *endingStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.end(err)
+	}
+	return err
+}
+
+// WithTelemetry sets the OpenTelemetry instrumentation of the client.
+func (c *GrpcClient) WithTelemetry(t *telemetry.Telemetry) *GrpcClient {
+	c.telemetry = t
+	return c
+}
+
+// WithLogger sets the logger used by the client. If logger is nil, slog.Default() is used.
+// Calls are logged at debug level, with credentials redacted from the metadata.
+func (c *GrpcClient) WithLogger(logger *slog.Logger) *GrpcClient {
+	c.logger = logger
+	return c
+}
+
+// Logger returns the logger used by the client.
+func (c *GrpcClient) Logger() *slog.Logger {
+	return loggerOrDefault(c.logger)
+}
+
+// Telemetry returns the OpenTelemetry instrumentation used by the client, it may be nil.
+func (c *GrpcClient) Telemetry() *telemetry.Telemetry {
+	if c == nil {
+		return nil
+	}
+	return c.telemetry
 }
 
 func (c *GrpcClient) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
 	ctxWithTimeoutAndHeaders, cancel := c.ctxWithTimeoutWithHeaders(ctx)
 	defer cancel()
 
-	return c.client.Search(ctxWithTimeoutAndHeaders, req, c.getOptions()...)
+	// search is read-only and may be hedged
+	return c.client.Search(ctxWithTimeoutAndHeaders, req, c.getOptions(true)...)
+}
+
+func (c *GrpcClient) Aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	ctxWithTimeoutAndHeaders, cancel := c.ctxWithTimeoutWithHeaders(ctx)
+	defer cancel()
+
+	// aggregate is read-only and may be hedged
+	return c.client.Aggregate(ctxWithTimeoutAndHeaders, req, c.getOptions(true)...)
 }
 
 func (c *GrpcClient) BatchObjects(ctx context.Context, objects []*models.Object,
@@ -62,7 +241,38 @@ func (c *GrpcClient) doBatchObjects(ctx context.Context, batchRequest *pb.BatchO
 	ctxWithTimeoutAndHeaders, cancel := c.ctxWithTimeoutWithHeaders(ctx)
 	defer cancel()
 
-	return c.client.BatchObjects(ctxWithTimeoutAndHeaders, batchRequest, c.getOptions()...)
+	return c.client.BatchObjects(ctxWithTimeoutAndHeaders, batchRequest, c.getOptions(false)...)
+}
+
+func (c *GrpcClient) BatchReferences(ctx context.Context, references []*models.BatchReference,
+	consistencyLevel string,
+) ([]models.BatchReferenceResponse, error) {
+	batchReferences, err := c.batch.GetBatchReferences(references)
+	if err != nil {
+		return nil, err
+	}
+	ctxWithTimeoutAndHeaders, cancel := c.ctxWithTimeoutWithHeaders(ctx)
+	defer cancel()
+
+	reply, err := c.client.BatchReferences(ctxWithTimeoutAndHeaders, &pb.BatchReferencesRequest{
+		References:       batchReferences,
+		ConsistencyLevel: c.batch.GetConsistencyLevel(consistencyLevel),
+	}, c.getOptions(false)...)
+	if err != nil {
+		return nil, fmt.Errorf("batch references: %w", err)
+	}
+	return c.batch.ParseReferencesReply(reply, references), nil
+}
+
+func (c *GrpcClient) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
+	ctxWithTimeoutAndHeaders, cancel := c.ctxWithTimeoutWithHeaders(ctx)
+	defer cancel()
+
+	reply, err := c.client.BatchDelete(ctxWithTimeoutAndHeaders, req, c.getOptions(false)...)
+	if err != nil {
+		return nil, fmt.Errorf("batch delete: %w", err)
+	}
+	return reply, nil
 }
 
 func (c *GrpcClient) getBatchRequest(objects []*models.Object, consistencyLevel string) (*pb.BatchObjectsRequest, error) {
@@ -78,34 +288,55 @@ func (c *GrpcClient) getBatchRequest(objects []*models.Object, consistencyLevel
 
 func (c *GrpcClient) ctxWithTimeoutWithHeaders(ctx context.Context) (context.Context, context.CancelFunc) {
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, c.timeout)
-	if len(c.headers) > 0 {
-		return metadata.NewOutgoingContext(ctxWithTimeout, metadata.New(c.headers)), cancel
+	return c.ctxWithHeaders(ctxWithTimeout), cancel
+}
+
+// ctxWithHeaders adds the headers of the client to the outgoing metadata of ctx
+func (c *GrpcClient) ctxWithHeaders(ctx context.Context) context.Context {
+	if len(c.headers) == 0 {
+		return ctx
+	}
+	kv := make([]string, 0, 2*len(c.headers))
+	for key, value := range c.headers {
+		kv = append(kv, key, value)
 	}
-	return ctxWithTimeout, cancel
+	return metadata.AppendToOutgoingContext(ctx, kv...)
 }
 
-func (c *GrpcClient) getOptions() []grpc.CallOption {
-	return []grpc.CallOption{}
+func (c *GrpcClient) getOptions(readOnly bool) []grpc.CallOption {
+	return c.retryOption(readOnly)
 }
 
-func createClient(host string, secured bool, startupTimeout time.Duration,
-	keepaliveParams *keepalive.ClientParameters,
-) (pb.WeaviateClient, error) {
+func createClient(config *grpcconfig.Config, startupTimeout time.Duration, loadBalancing *loadbalance.Config,
+	lifecycleInterceptor GrpcInterceptor, interceptors []GrpcInterceptor, streamInterceptors []grpc.StreamClientInterceptor,
+) (*grpc.ClientConn, error) {
+	host, secured := config.Host, isSecured(config)
 	var opts []grpc.DialOption
-	if secured || strings.HasSuffix(host, ":443") {
-		tlsConfig := &tls.Config{
-			InsecureSkipVerify: true,
-		}
-		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if secured {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig(config))))
 	} else {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	}
 
-	if keepaliveParams != nil {
-		opts = append(opts, grpc.WithKeepaliveParams(*keepaliveParams))
+	if config.Keepalive != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(*config.Keepalive))
+	}
+	if config.ConnectParams != nil {
+		opts = append(opts, grpc.WithConnectParams(*config.ConnectParams))
 	}
+	// retries wrap the user's interceptors, so that these run once per attempt
+	chain := append([]GrpcInterceptor{lifecycleInterceptor, retryUnaryInterceptor}, interceptors...)
+	opts = append(opts, grpc.WithChainUnaryInterceptor(chain...))
+	opts = append(opts, grpc.WithChainStreamInterceptor(streamInterceptors...))
 
-	conn, err := grpc.NewClient(getAddress(host, secured), opts...)
+	target := getAddress(host, secured)
+	if len(config.Hosts) > 0 {
+		var balancingOpts []grpc.DialOption
+		target, balancingOpts = loadBalancingOptions(config, secured, loadBalancing)
+		opts = append(opts, balancingOpts...)
+	}
+
+	conn, err := grpc.NewClient(target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
 	}
@@ -116,11 +347,52 @@ func createClient(host string, secured bool, startupTimeout time.Duration,
 		_, err := client.Check(ctxWithTimeout, &grpc_health_v1.HealthCheckRequest{})
 		if err != nil {
 			cancel()
+			conn.Close()
 			return nil, fmt.Errorf("failed to connect to host: %s with secured set to: %v: %w", host, secured, err)
 		}
 		cancel()
 	}
-	return pb.NewWeaviateClient(conn), nil
+	return conn, nil
+}
+
+// loadBalancingOptions returns the target and dial options which spread calls across all hosts of config.
+// Nodes failing the gRPC health check are skipped until they become healthy again.
+func loadBalancingOptions(config *grpcconfig.Config, secured bool, loadBalancing *loadbalance.Config) (string, []grpc.DialOption) {
+	hosts := append([]string{config.Host}, config.Hosts...)
+	addresses := make([]resolver.Address, 0, len(hosts))
+	for _, host := range hosts {
+		address := getAddress(host, secured)
+		serverName, _, err := net.SplitHostPort(address)
+		if err != nil {
+			serverName = host
+		}
+		addresses = append(addresses, resolver.Address{Addr: address, ServerName: serverName})
+	}
+	r := manual.NewBuilderWithScheme("weaviate")
+	r.InitialState(resolver.State{Addresses: addresses})
+
+	policy := `{"round_robin":{}}`
+	if loadBalancing.GetPolicy() == loadbalance.LeastInFlight {
+		policy = fmt.Sprintf(`{%q:{"choiceCount":2}}`, leastrequest.Name)
+	}
+	serviceConfig := fmt.Sprintf(`{"loadBalancingConfig":[%s],"healthCheckConfig":{"serviceName":""}}`, policy)
+	return r.Scheme() + ":///weaviate", []grpc.DialOption{
+		grpc.WithResolvers(r),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+	}
+}
+
+func isSecured(config *grpcconfig.Config) bool {
+	return config.Secured || config.TLSConfig != nil || strings.HasSuffix(config.Host, ":443")
+}
+
+// tlsConfig returns a copy of the configured TLS config, which verifies the
+// server certificate against the system's root CAs by default
+func tlsConfig(config *grpcconfig.Config) *tls.Config {
+	if config.TLSConfig != nil {
+		return config.TLSConfig.Clone()
+	}
+	return &tls.Config{MinVersion: tls.VersionTLS12}
 }
 
 func getAddress(host string, secured bool) string {
@@ -0,0 +1,94 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+// BatchStream is an open BatchStream RPC, which sends objects and references to Weaviate
+// as they are produced and receives acknowledgements, results and backpressure messages.
+// Send may be called concurrently with Recv, but not with itself.
+type BatchStream struct {
+	client *GrpcClient
+	stream pb.Weaviate_BatchStreamClient
+	cancel context.CancelFunc
+}
+
+// OpenBatchStream opens a batch stream and waits until the server has started it.
+// The stream lives until it is stopped or ctx is cancelled. Closing the client waits
+// for open streams to end, opening one after the client is closed fails with
+// fault.ErrClientClosed.
+func (c *GrpcClient) OpenBatchStream(ctx context.Context, consistencyLevel string) (*BatchStream, error) {
+	// streams are long-lived, so only the headers and not the timeout are applied
+	ctx, cancel := context.WithCancel(c.ctxWithHeaders(ctx))
+	stream, err := c.client.BatchStream(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("open batch stream: %w", err)
+	}
+	start := &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Start_{
+		Start: &pb.BatchStreamRequest_Start{ConsistencyLevel: c.batch.GetConsistencyLevel(consistencyLevel)},
+	}}
+	if err := stream.Send(start); err != nil {
+		cancel()
+		return nil, fmt.Errorf("start batch stream: %w", err)
+	}
+	reply, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("start batch stream: %w", err)
+	}
+	if reply.GetStarted() == nil {
+		cancel()
+		return nil, errors.New("start batch stream: server did not acknowledge the start of the stream")
+	}
+	return &BatchStream{client: c, stream: stream, cancel: cancel}, nil
+}
+
+// Send sends objects and references in one message.
+func (s *BatchStream) Send(objects []*models.Object, references []*models.BatchReference) error {
+	batchObjects, err := s.client.batch.GetBatchObjects(objects)
+	if err != nil {
+		return err
+	}
+	batchReferences, err := s.client.batch.GetBatchReferences(references)
+	if err != nil {
+		return err
+	}
+	data := &pb.BatchStreamRequest_Data{}
+	if len(batchObjects) > 0 {
+		data.Objects = &pb.BatchStreamRequest_Data_Objects{Values: batchObjects}
+	}
+	if len(batchReferences) > 0 {
+		data.References = &pb.BatchStreamRequest_Data_References{Values: batchReferences}
+	}
+	if err := s.stream.Send(&pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Data_{Data: data}}); err != nil {
+		return fmt.Errorf("send batch: %w", err)
+	}
+	return nil
+}
+
+// Recv returns the next message of the server. It returns io.EOF once the server
+// has sent every result after the stream was stopped.
+func (s *BatchStream) Recv() (*pb.BatchStreamReply, error) {
+	reply, err := s.stream.Recv()
+	if err != nil {
+		// the stream has ended
+		s.cancel()
+	}
+	return reply, err
+}
+
+// Stop tells the server that no more data will be sent. The server keeps sending
+// results of the data it has received so far.
+func (s *BatchStream) Stop() error {
+	stop := &pb.BatchStreamRequest{Message: &pb.BatchStreamRequest_Stop_{Stop: &pb.BatchStreamRequest_Stop{}}}
+	if err := s.stream.Send(stop); err != nil {
+		return fmt.Errorf("stop batch stream: %w", err)
+	}
+	return s.stream.CloseSend()
+}
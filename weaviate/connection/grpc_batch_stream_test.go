@@ -0,0 +1,145 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/fault"
+	grpcconfig "github.com/weaviate/weaviate-go-client/v5/weaviate/grpc"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataServer sends the metadata of every call to md. It answers searches with an
+// empty reply, starts every batch stream and ends it once the client has stopped it.
+type metadataServer struct {
+	pb.UnimplementedWeaviateServer
+	md chan metadata.MD
+}
+
+func (s *metadataServer) Search(ctx context.Context, _ *pb.SearchRequest) (*pb.SearchReply, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.md <- md
+	return &pb.SearchReply{}, nil
+}
+
+func (s *metadataServer) BatchStream(stream grpc.BidiStreamingServer[pb.BatchStreamRequest, pb.BatchStreamReply]) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.md <- md
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.BatchStreamReply{Message: &pb.BatchStreamReply_Started_{Started: &pb.BatchStreamReply_Started{}}}); err != nil {
+		return err
+	}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) || req.GetStop() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func newMetadataClient(t *testing.T) (*GrpcClient, *metadataServer) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &metadataServer{md: make(chan metadata.MD, 1)}
+	grpcServer := grpc.NewServer()
+	pb.RegisterWeaviateServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+
+	client, err := NewGrpcClientWithOptions(GrpcOptions{
+		Config:  &grpcconfig.Config{Host: listener.Addr().String()},
+		Headers: map[string]string{"x-custom": "header"},
+		Timeout: time.Second,
+	})
+	require.NoError(t, err)
+	return client, server
+}
+
+// stopBatchStream stops stream and receives until the server has ended it
+func stopBatchStream(t *testing.T, stream *BatchStream) {
+	t.Helper()
+	require.NoError(t, stream.Stop())
+	for {
+		if _, err := stream.Recv(); err != nil {
+			require.ErrorIs(t, err, io.EOF)
+			return
+		}
+	}
+}
+
+func TestGrpcClient_OpenBatchStream(t *testing.T) {
+	t.Run("keeps the metadata of the context", func(t *testing.T) {
+		client, server := newMetadataClient(t)
+		defer client.Close()
+
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request", "request")
+		stream, err := client.OpenBatchStream(ctx, "")
+		require.NoError(t, err)
+		md := <-server.md
+		assert.Equal(t, []string{"header"}, md.Get("x-custom"))
+		assert.Equal(t, []string{"request"}, md.Get("x-request"))
+		stopBatchStream(t, stream)
+	})
+
+	t.Run("propagates the trace context", func(t *testing.T) {
+		client, server := newMetadataClient(t)
+		defer client.Close()
+		recorder := tracetest.NewSpanRecorder()
+		tel, err := telemetry.New(&telemetry.Config{
+			TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+			Propagator:     propagation.TraceContext{},
+		})
+		require.NoError(t, err)
+		client.WithTelemetry(tel)
+
+		stream, err := client.OpenBatchStream(context.Background(), "")
+		require.NoError(t, err)
+		md := <-server.md
+		assert.Len(t, md.Get("traceparent"), 1)
+		assert.Equal(t, []string{"header"}, md.Get("x-custom"))
+		stopBatchStream(t, stream)
+
+		require.Eventually(t, func() bool { return len(recorder.Ended()) == 1 }, time.Second, time.Millisecond)
+		span := recorder.Ended()[0]
+		assert.Equal(t, "/weaviate.v1.Weaviate/BatchStream", span.Name())
+		assert.Contains(t, md.Get("traceparent")[0], span.SpanContext().SpanID().String())
+	})
+
+	t.Run("close waits for open streams", func(t *testing.T) {
+		client, server := newMetadataClient(t)
+		stream, err := client.OpenBatchStream(context.Background(), "")
+		require.NoError(t, err)
+		<-server.md
+
+		closed := make(chan error)
+		go func() { closed <- client.Close() }()
+		select {
+		case <-closed:
+			t.Fatal("close returned while a stream was open")
+		case <-time.After(50 * time.Millisecond):
+		}
+		stopBatchStream(t, stream)
+		require.NoError(t, <-closed)
+
+		_, err = client.OpenBatchStream(context.Background(), "")
+		assert.ErrorIs(t, err, fault.ErrClientClosed)
+	})
+}
@@ -0,0 +1,163 @@
+package connection
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	grpcconfig "github.com/weaviate/weaviate-go-client/v5/weaviate/grpc"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/retry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// retryCallOption carries the retry policy of a call to the retry interceptor
+type retryCallOption struct {
+	grpc.EmptyCallOption
+	config *grpcconfig.RetryConfig
+	hedge  bool
+}
+
+func (c *GrpcClient) retryOption(hedge bool) []grpc.CallOption {
+	if c.retry == nil {
+		return nil
+	}
+	return []grpc.CallOption{retryCallOption{config: c.retry, hedge: hedge}}
+}
+
+func retryUnaryInterceptor(ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	var policy *retryCallOption
+	callOpts := make([]grpc.CallOption, 0, len(opts))
+	for _, opt := range opts {
+		if o, ok := opt.(retryCallOption); ok {
+			policy = &o
+			continue
+		}
+		callOpts = append(callOpts, opt)
+	}
+	if policy == nil {
+		return invoker(ctx, method, req, reply, cc, callOpts...)
+	}
+
+	r := grpcRetrier{config: policy.config}
+	attempt := func(ctx context.Context, reply any) error {
+		return r.invoke(ctx, method, req, reply, cc, invoker, callOpts...)
+	}
+	if msg, ok := reply.(proto.Message); ok && policy.hedge && policy.config.HedgingDelay > 0 {
+		return r.hedged(ctx, msg, attempt)
+	}
+	return r.sequential(ctx, reply, attempt)
+}
+
+type grpcRetrier struct {
+	config *grpcconfig.RetryConfig
+}
+
+func (r grpcRetrier) backoff() *retry.Config {
+	return &retry.Config{
+		MaxAttempts:    r.config.MaxAttempts,
+		InitialBackoff: r.config.InitialBackoff,
+		MaxBackoff:     r.config.MaxBackoff,
+		Jitter:         r.config.Jitter,
+	}
+}
+
+func (r grpcRetrier) invoke(ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	if r.config.PerAttemptTimeout <= 0 {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	attemptCtx, cancel := context.WithTimeout(ctx, r.config.PerAttemptTimeout)
+	defer cancel()
+	return invoker(attemptCtx, method, req, reply, cc, opts...)
+}
+
+func (r grpcRetrier) isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	code := status.Code(err)
+	if code == codes.DeadlineExceeded && r.config.PerAttemptTimeout > 0 {
+		// the attempt timed out, but the call's deadline has not expired yet
+		return true
+	}
+	if len(r.config.Codes) == 0 {
+		return slices.Contains(grpcconfig.DefaultRetryableCodes, code)
+	}
+	return slices.Contains(r.config.Codes, code)
+}
+
+func (r grpcRetrier) sequential(ctx context.Context, reply any, attempt func(context.Context, any) error) error {
+	backoff := r.backoff()
+	for n := 1; ; n++ {
+		err := attempt(ctx, reply)
+		if err == nil || n >= backoff.Attempts() || !r.isRetryable(ctx, err) {
+			return err
+		}
+		if retry.Wait(ctx, backoff.Backoff(n)) != nil {
+			return err
+		}
+	}
+}
+
+// hedged starts a new attempt every HedgingDelay (or immediately after a retryable failure)
+// until one of them succeeds or MaxAttempts is reached. The first successful reply is merged into reply.
+func (r grpcRetrier) hedged(ctx context.Context, reply proto.Message, attempt func(context.Context, any) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		reply proto.Message
+		err   error
+	}
+	attempts := r.backoff().Attempts()
+	results := make(chan result, attempts)
+	start := func() {
+		attemptReply := proto.Clone(reply)
+		go func() {
+			err := attempt(ctx, attemptReply)
+			results <- result{attemptReply, err}
+		}()
+	}
+
+	start()
+	started, pending := 1, 1
+	timer := time.NewTimer(r.config.HedgingDelay)
+	defer timer.Stop()
+	var lastErr error
+	for pending > 0 {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				proto.Reset(reply)
+				proto.Merge(reply, res.reply)
+				return nil
+			}
+			lastErr = res.err
+			if !r.isRetryable(ctx, res.err) {
+				return res.err
+			}
+			if started < attempts {
+				start()
+				started++
+				pending++
+			}
+		case <-timer.C:
+			if started < attempts {
+				start()
+				started++
+				pending++
+				timer.Reset(r.config.HedgingDelay)
+			}
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	return lastErr
+}
@@ -0,0 +1,75 @@
+package connection
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpcconfig "github.com/weaviate/weaviate-go-client/v5/weaviate/grpc"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingInvoker fails the first `failures` calls with code and succeeds afterwards.
+func failingInvoker(calls *atomic.Int32, failures int32, code codes.Code) grpc.UnaryInvoker {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if calls.Add(1) <= failures {
+			return status.Error(code, "failed")
+		}
+		reply.(*pb.SearchReply).Took = 1
+		return nil
+	}
+}
+
+func TestRetryUnaryInterceptor(t *testing.T) {
+	config := &grpcconfig.RetryConfig{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	t.Run("retries retryable codes", func(t *testing.T) {
+		var calls atomic.Int32
+		reply := &pb.SearchReply{}
+		err := retryUnaryInterceptor(context.Background(), "/search", &pb.SearchRequest{}, reply, nil,
+			failingInvoker(&calls, 2, codes.Unavailable), retryCallOption{config: config})
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), calls.Load())
+		assert.Equal(t, float32(1), reply.Took)
+	})
+
+	t.Run("does not retry other codes", func(t *testing.T) {
+		var calls atomic.Int32
+		err := retryUnaryInterceptor(context.Background(), "/search", &pb.SearchRequest{}, &pb.SearchReply{}, nil,
+			failingInvoker(&calls, 2, codes.InvalidArgument), retryCallOption{config: config})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("does not retry without policy", func(t *testing.T) {
+		var calls atomic.Int32
+		err := retryUnaryInterceptor(context.Background(), "/search", &pb.SearchRequest{}, &pb.SearchReply{}, nil,
+			failingInvoker(&calls, 2, codes.Unavailable))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("hedges slow calls", func(t *testing.T) {
+		var calls atomic.Int32
+		slowFirst := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			if calls.Add(1) == 1 {
+				<-ctx.Done()
+				return status.FromContextError(ctx.Err()).Err()
+			}
+			reply.(*pb.SearchReply).Took = 2
+			return nil
+		}
+		hedging := &grpcconfig.RetryConfig{MaxAttempts: 2, HedgingDelay: 10 * time.Millisecond}
+		reply := &pb.SearchReply{}
+		err := retryUnaryInterceptor(context.Background(), "/search", &pb.SearchRequest{}, reply, nil,
+			slowFirst, retryCallOption{config: hedging, hedge: true})
+		require.NoError(t, err)
+		assert.Equal(t, float32(2), reply.Took)
+	})
+}
@@ -0,0 +1,84 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadataCarrier adapts gRPC metadata to propagation.TextMapCarrier
+type metadataCarrier metadata.MD
+
+func (mc metadataCarrier) Get(key string) string {
+	if values := metadata.MD(mc).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (mc metadataCarrier) Set(key, value string) {
+	metadata.MD(mc).Set(key, value)
+}
+
+func (mc metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(mc))
+	for k := range mc {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (c *GrpcClient) telemetryInterceptor(ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	if c.telemetry == nil {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	ctx, op := c.telemetry.StartRequest(ctx, method, telemetry.GRPC(), telemetry.GRPCMethod(method))
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	c.telemetry.Inject(ctx, metadataCarrier(md))
+	err := invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+	op.SetAttributes(telemetry.GRPCStatusCode(uint32(status.Code(err))))
+	op.End(err)
+	return err
+}
+
+func (c *GrpcClient) telemetryStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc,
+	cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if c.telemetry == nil {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+	ctx, op := c.telemetry.StartRequest(ctx, method, telemetry.GRPC(), telemetry.GRPCMethod(method))
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	c.telemetry.Inject(ctx, metadataCarrier(md))
+	stream, err := streamer(metadata.NewOutgoingContext(ctx, md), desc, cc, method, opts...)
+	if err != nil {
+		op.SetAttributes(telemetry.GRPCStatusCode(uint32(status.Code(err))))
+		op.End(err)
+		return nil, err
+	}
+	// the span covers the whole stream, which ends without error once the server has sent everything
+	return onStreamEnd(stream, func(err error) {
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+		op.SetAttributes(telemetry.GRPCStatusCode(uint32(status.Code(err))))
+		op.End(err)
+	}), nil
+}
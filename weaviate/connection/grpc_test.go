@@ -0,0 +1,70 @@
+package connection
+
+import (
+	"context"
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	grpcconfig "github.com/weaviate/weaviate-go-client/v5/weaviate/grpc"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestGrpcTLSConfig(t *testing.T) {
+	t.Run("verifies certificates by default", func(t *testing.T) {
+		config := &grpcconfig.Config{Host: "weaviate.example.com", Secured: true}
+		assert.True(t, isSecured(config))
+		assert.False(t, tlsConfig(config).InsecureSkipVerify)
+	})
+
+	t.Run("uses a copy of the given tls config", func(t *testing.T) {
+		given := &tls.Config{ServerName: "weaviate.internal"}
+		config := &grpcconfig.Config{Host: "10.0.0.1:50051", TLSConfig: given}
+		assert.True(t, isSecured(config))
+		got := tlsConfig(config)
+		assert.Equal(t, "weaviate.internal", got.ServerName)
+		assert.NotSame(t, given, got)
+	})
+
+	t.Run("insecure", func(t *testing.T) {
+		assert.False(t, isSecured(&grpcconfig.Config{Host: "localhost:50051"}))
+		assert.True(t, isSecured(&grpcconfig.Config{Host: "weaviate.example.com:443"}))
+	})
+}
+
+func TestGrpcClient_Telemetry(t *testing.T) {
+	client, server := newMetadataClient(t)
+	defer client.Close()
+	recorder := tracetest.NewSpanRecorder()
+	tel, err := telemetry.New(&telemetry.Config{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		Propagator:     propagation.TraceContext{},
+	})
+	require.NoError(t, err)
+	client.WithTelemetry(tel)
+
+	ctx, op := tel.Start(context.Background(), "graphql.Search")
+	_, err = client.Search(ctx, &pb.SearchRequest{})
+	require.NoError(t, err)
+	op.End(err)
+
+	md := <-server.md
+	assert.Equal(t, []string{"header"}, md.Get("x-custom"))
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	request, operation := spans[0], spans[1]
+	assert.Equal(t, "/weaviate.v1.Weaviate/Search", request.Name())
+	assert.Equal(t, trace.SpanKindClient, request.SpanKind())
+	assert.Equal(t, operation.SpanContext().SpanID(), request.Parent().SpanID())
+	// the server continues the trace of the request
+	carrier := propagation.MapCarrier{"traceparent": md.Get("traceparent")[0]}
+	remote := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+	assert.Equal(t, request.SpanContext().TraceID(), remote.TraceID())
+	assert.Equal(t, request.SpanContext().SpanID(), remote.SpanID())
+}
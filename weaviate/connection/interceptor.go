@@ -0,0 +1,30 @@
+package connection
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc"
+)
+
+// RESTHandler sends a REST request to Weaviate and returns its response.
+type RESTHandler func(request *http.Request) (*http.Response, error)
+
+// RESTInterceptor wraps the execution of every REST request. It may modify the
+// request before calling next, inspect or replace the response, or return an
+// error without calling next at all. Interceptors run once per attempt, so a
+// retried request passes through them again.
+type RESTInterceptor func(request *http.Request, next RESTHandler) (*http.Response, error)
+
+// GrpcInterceptor is a unary interceptor applied to every gRPC call. Like
+// REST interceptors, it runs once per attempt.
+type GrpcInterceptor = grpc.UnaryClientInterceptor
+
+func chainRESTInterceptors(handler RESTHandler, interceptors []RESTInterceptor) RESTHandler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(request *http.Request) (*http.Response, error) {
+			return interceptor(request, next)
+		}
+	}
+	return handler
+}
@@ -0,0 +1,88 @@
+package connection
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const redacted = "[REDACTED]"
+
+// isSensitiveHeader reports whether the value of a header carries credentials,
+// e.g. Authorization, X-Weaviate-Api-Key or X-OpenAI-Api-Key
+func isSensitiveHeader(name string) bool {
+	lower := strings.ToLower(name)
+	return lower == "authorization" || lower == "proxy-authorization" || lower == "cookie" ||
+		strings.Contains(lower, "api-key") || strings.Contains(lower, "apikey") ||
+		strings.Contains(lower, "token") || strings.Contains(lower, "secret")
+}
+
+func redactedHeaders(header map[string][]string) slog.Attr {
+	attrs := make([]any, 0, len(header))
+	for name, values := range header {
+		value := strings.Join(values, ",")
+		if isSensitiveHeader(name) {
+			value = redacted
+		}
+		attrs = append(attrs, slog.String(name, value))
+	}
+	return slog.Group("headers", attrs...)
+}
+
+func loggerOrDefault(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		return slog.Default()
+	}
+	return logger
+}
+
+func (con *Connection) logRequest(ctx context.Context, request *http.Request, response *http.Response,
+	err error, duration time.Duration,
+) {
+	logger := con.Logger()
+	if !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	attrs := []any{
+		slog.String("method", request.Method),
+		slog.String("url", request.URL.Redacted()),
+		redactedHeaders(request.Header),
+		slog.Int64("request_size", request.ContentLength),
+		slog.Duration("duration", duration),
+	}
+	if err != nil {
+		logger.DebugContext(ctx, "weaviate request failed", append(attrs, slog.Any("error", err))...)
+		return
+	}
+	logger.DebugContext(ctx, "weaviate request", append(attrs, slog.Int("status_code", response.StatusCode))...)
+}
+
+func (c *GrpcClient) loggingInterceptor(ctx context.Context, method string, req, reply any,
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+) error {
+	logger := c.Logger()
+	if !logger.Enabled(ctx, slog.LevelDebug) {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	attrs := []any{
+		slog.String("method", method),
+		redactedHeaders(md),
+		slog.String("code", status.Code(err).String()),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if err != nil {
+		logger.DebugContext(ctx, "weaviate grpc call failed", append(attrs, slog.Any("error", err))...)
+	} else {
+		logger.DebugContext(ctx, "weaviate grpc call", attrs...)
+	}
+	return err
+}
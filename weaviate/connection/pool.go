@@ -0,0 +1,166 @@
+package connection
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/loadbalance"
+)
+
+type readOnlyKey struct{}
+
+// WithReadOnly returns a context which marks a request as read-only, so that it can be
+// sent to any node of the cluster even though its HTTP method is not GET, e.g. GraphQL queries.
+func WithReadOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readOnlyKey{}, true)
+}
+
+func isReadOnly(ctx context.Context, restMethod string) bool {
+	if restMethod == http.MethodGet || restMethod == http.MethodHead {
+		return true
+	}
+	readOnly, _ := ctx.Value(readOnlyKey{}).(bool)
+	return readOnly
+}
+
+// endpoint is a Weaviate node requests are sent to
+type endpoint struct {
+	basePath string
+	inFlight atomic.Int64
+	failures atomic.Int32
+	ejected  atomic.Bool
+}
+
+// endpointPool spreads requests across the nodes of a cluster and ejects unhealthy nodes
+type endpointPool struct {
+	mutex            sync.RWMutex
+	endpoints        []*endpoint
+	policy           loadbalance.Policy
+	failureThreshold int32
+	next             atomic.Uint64
+
+	done     chan struct{}
+	stopped  chan struct{}
+	stopOnce sync.Once
+}
+
+func newEndpointPool(basePath string) *endpointPool {
+	return &endpointPool{
+		endpoints:        []*endpoint{{basePath: basePath}},
+		policy:           loadbalance.RoundRobin,
+		failureThreshold: int32((*loadbalance.Config)(nil).GetFailureThreshold()),
+	}
+}
+
+func (p *endpointPool) add(basePaths ...string) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, basePath := range basePaths {
+		if !slices.ContainsFunc(p.endpoints, func(e *endpoint) bool { return e.basePath == basePath }) {
+			p.endpoints = append(p.endpoints, &endpoint{basePath: basePath})
+		}
+	}
+}
+
+func (p *endpointPool) snapshot() []*endpoint {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return slices.Clone(p.endpoints)
+}
+
+// pick the endpoint of the next request. Writes go to the first healthy endpoint,
+// reads are spread across all healthy endpoints according to the policy.
+func (p *endpointPool) pick(readOnly bool) *endpoint {
+	endpoints := p.snapshot()
+	healthy := slices.DeleteFunc(slices.Clone(endpoints), func(e *endpoint) bool { return e.ejected.Load() })
+	if len(healthy) == 0 {
+		// every node is ejected, keep trying all of them rather than failing right away
+		healthy = endpoints
+	}
+	if !readOnly || len(healthy) == 1 {
+		return healthy[0]
+	}
+	offset := int(p.next.Add(1) % uint64(len(healthy)))
+	if p.policy != loadbalance.LeastInFlight {
+		return healthy[offset]
+	}
+	picked := healthy[offset]
+	for i := range healthy {
+		if e := healthy[(offset+i)%len(healthy)]; e.inFlight.Load() < picked.inFlight.Load() {
+			picked = e
+		}
+	}
+	return picked
+}
+
+// report the outcome of a request sent to e
+func (p *endpointPool) report(e *endpoint, failed bool) {
+	if !failed {
+		e.failures.Store(0)
+		return
+	}
+	if e.failures.Add(1) >= p.failureThreshold {
+		e.ejected.Store(true)
+	}
+}
+
+func isNodeFailure(responseData *ResponseData, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch responseData.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// startProbes periodically checks the readiness of every endpoint. isReady must not
+// reference the connection, otherwise it is never garbage collected and its finalizer
+// never stops the probes.
+func (p *endpointPool) startProbes(interval time.Duration, isReady func(ctx context.Context, basePath string) bool) {
+	p.done, p.stopped = make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(p.stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-p.done:
+				return
+			case <-ticker.C:
+				p.probe(interval, isReady)
+			}
+		}
+	}()
+}
+
+func (p *endpointPool) probe(timeout time.Duration, isReady func(ctx context.Context, basePath string) bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	for _, e := range p.snapshot() {
+		wg.Go(func() {
+			ready := isReady(ctx, e.basePath)
+			if ready {
+				e.failures.Store(0)
+			}
+			e.ejected.Store(!ready)
+		})
+	}
+	wg.Wait()
+}
+
+// stopProbes stops the probes and waits until they have stopped, it may be called more than once
+func (p *endpointPool) stopProbes() {
+	if p.done == nil {
+		return
+	}
+	p.stopOnce.Do(func() { close(p.done) })
+	<-p.stopped
+}
@@ -0,0 +1,102 @@
+package connection
+
+import (
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// tokenRefresher periodically refreshes the auth token in the background.
+// It does not reference the Connection, so that an unused connection can still be finalized.
+type tokenRefresher struct {
+	done     chan struct{}
+	stopped  chan struct{}
+	stopOnce sync.Once
+	logger   atomic.Pointer[slog.Logger]
+}
+
+// startTokenRefresher starts a background goroutine that periodically refreshes the auth token.
+// The oauth2 package only refreshes the Tokens on new http requests => if there is no request for the lifetime of
+// the refresh token the client will become de-authenticated without this.
+// The first token is checked right away and problems are logged to logger.
+// It returns nil if no refresh is needed.
+func startTokenRefresher(transport *oauth2.Transport, logger *slog.Logger) *tokenRefresher {
+	r := &tokenRefresher{done: make(chan struct{}), stopped: make(chan struct{})}
+	r.logger.Store(logger)
+	token, err := transport.Source.Token()
+	if err != nil {
+		r.log().Error("Error during token refresh, getting token", "error", err)
+		return nil
+	}
+
+	if time.Until(token.Expiry) < 0 {
+		r.log().Warn("Requested token is expired")
+		return nil
+	}
+
+	// there is no point in manual refreshing if there is no refresh token. Note that this is the default with client
+	// credentials
+	if token.RefreshToken == "" {
+		return nil
+	}
+
+	go func() {
+		defer close(r.stopped)
+		// initial sleep before requesting a token
+		timeToSleep := time.Until(token.Expiry) - time.Second*10
+		for {
+			if !r.sleep(timeToSleep) {
+				return
+			}
+			token, err = transport.Source.Token()
+			if token == nil || time.Until(token.Expiry) < 0 {
+				r.log().Warn("Requested token is expired. Stop requesting new access token.")
+				return
+			}
+			if err != nil {
+				r.log().Error("Error during token refresh, getting token", "error", err)
+				timeToSleep = time.Second
+			} else {
+				timeToSleep = time.Until(token.Expiry) - time.Second*10
+			}
+		}
+	}()
+	return r
+}
+
+// sleep returns false if the refresher was stopped while sleeping
+func (r *tokenRefresher) sleep(d time.Duration) bool {
+	if d <= 0 {
+		d = 0
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-r.done:
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// stop the refresh goroutine and wait for it to exit
+func (r *tokenRefresher) stop() {
+	if r == nil {
+		return
+	}
+	r.stopOnce.Do(func() { close(r.done) })
+	<-r.stopped
+}
+
+func (r *tokenRefresher) setLogger(logger *slog.Logger) {
+	if r != nil {
+		r.logger.Store(logger)
+	}
+}
+
+func (r *tokenRefresher) log() *slog.Logger {
+	return loggerOrDefault(r.logger.Load())
+}
@@ -6,12 +6,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"runtime"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/fault"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/loadbalance"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/retry"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	"go.opentelemetry.io/otel/propagation"
 	"golang.org/x/oauth2"
 )
 
@@ -19,40 +25,163 @@ const apiVersion = "v1"
 
 // Connection networking layer accessing weaviate using http requests
 type Connection struct {
+	scheme     string
 	basePath   string
+	pool       *endpointPool
 	httpClient *http.Client
 	headers    map[string]string
-	doneCh     chan bool
+	retry      *retry.Config
+	handler    RESTHandler
+	telemetry  *telemetry.Telemetry
+	logger     atomic.Pointer[slog.Logger]
+	refresher  *tokenRefresher
+
+	mutex    sync.RWMutex
+	closed   bool
+	inFlight sync.WaitGroup
 }
 
 func finalizer(c *Connection) {
-	c.doneCh <- true
+	c.pool.stopProbes()
+	c.refresher.stop()
+}
+
+// ConnectionOptions configures a Connection created with NewConnectionWithOptions.
+type ConnectionOptions struct {
+	Scheme string
+	Host   string
+	// HTTPClient sends the requests, a client with Timeout is used if nil.
+	HTTPClient *http.Client
+	Timeout    time.Duration
+	// Headers sent with every request.
+	Headers map[string]string
+	// Logger of the connection, including the token refresh started with it. slog.Default() is used if nil.
+	Logger *slog.Logger
 }
 
 // NewConnection based on scheme://host
 // if httpClient is nil a default client will be used
 func NewConnection(scheme string, host string, httpClient *http.Client, timeout time.Duration, headers map[string]string) *Connection {
-	client := httpClient
+	return NewConnectionWithOptions(ConnectionOptions{
+		Scheme:     scheme,
+		Host:       host,
+		HTTPClient: httpClient,
+		Timeout:    timeout,
+		Headers:    headers,
+	})
+}
+
+// NewConnectionWithOptions creates a connection to options.Scheme://options.Host.
+func NewConnectionWithOptions(options ConnectionOptions) *Connection {
+	client := options.HTTPClient
 	if client == nil {
-		client = &http.Client{Timeout: timeout}
+		client = &http.Client{Timeout: options.Timeout}
 	}
 	connection := &Connection{
-		basePath:   scheme + "://" + host + "/" + apiVersion,
+		scheme:     options.Scheme,
+		basePath:   options.Scheme + "://" + options.Host + "/" + apiVersion,
 		httpClient: client,
-		headers:    headers,
-		doneCh:     make(chan bool),
+		headers:    options.Headers,
 	}
+	connection.handler = connection.httpClient.Do
+	connection.pool = newEndpointPool(connection.basePath)
+	connection.logger.Store(options.Logger)
 
 	// shutdown goroutine when connections is cleaned up
 	runtime.SetFinalizer(connection, finalizer)
 	transport, ok := connection.httpClient.Transport.(*oauth2.Transport)
 	if ok {
-		connection.startRefreshGoroutine(transport)
+		connection.refresher = startTokenRefresher(transport, options.Logger)
 	}
 
 	return connection
 }
 
+// WithRetry sets the retry policy applied to every request of the connection.
+// A nil config disables retries.
+func (con *Connection) WithRetry(config *retry.Config) *Connection {
+	con.retry = config
+	return con
+}
+
+// WithLoadBalancing spreads requests across the given hosts in addition to the connection's host,
+// according to config. Unhealthy hosts are ejected after repeated failures and probed periodically.
+// If config is nil, the defaults are used.
+func (con *Connection) WithLoadBalancing(config *loadbalance.Config, hosts ...string) *Connection {
+	if config == nil && len(hosts) == 0 {
+		return con
+	}
+	con.pool.policy = config.GetPolicy()
+	con.pool.failureThreshold = int32(config.GetFailureThreshold())
+	con.AddHosts(hosts...)
+	con.pool.startProbes(config.GetProbeInterval(), readinessProbe(con.httpClient, con.headers))
+	return con
+}
+
+// AddHosts adds Weaviate nodes which requests are spread across.
+func (con *Connection) AddHosts(hosts ...string) {
+	basePaths := make([]string, len(hosts))
+	for i, host := range hosts {
+		basePaths[i] = con.scheme + "://" + host + "/" + apiVersion
+	}
+	con.pool.add(basePaths...)
+}
+
+// readinessProbe returns a function which checks whether the node at basePath is ready.
+// It only holds on to the client and headers, so that the connection can be finalized.
+func readinessProbe(httpClient *http.Client, headers map[string]string) func(ctx context.Context, basePath string) bool {
+	return func(ctx context.Context, basePath string) bool {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, basePath+"/.well-known/ready", nil)
+		if err != nil {
+			return false
+		}
+		for k, v := range headers {
+			request.Header.Add(k, v)
+		}
+		response, err := httpClient.Do(request)
+		if err != nil {
+			return false
+		}
+		defer response.Body.Close()
+		_, _ = io.Copy(io.Discard, response.Body)
+		return response.StatusCode == http.StatusOK
+	}
+}
+
+// WithInterceptors sets the interceptors which wrap every REST request of the connection.
+// The first interceptor is the outermost one.
+func (con *Connection) WithInterceptors(interceptors ...RESTInterceptor) *Connection {
+	con.handler = chainRESTInterceptors(con.httpClient.Do, interceptors)
+	return con
+}
+
+// WithLogger sets the logger used by the connection. If logger is nil, slog.Default() is used.
+// Requests and responses are logged at debug level, with credentials redacted from the headers.
+func (con *Connection) WithLogger(logger *slog.Logger) *Connection {
+	con.logger.Store(logger)
+	con.refresher.setLogger(logger)
+	return con
+}
+
+// Logger returns the logger used by the connection.
+func (con *Connection) Logger() *slog.Logger {
+	return loggerOrDefault(con.logger.Load())
+}
+
+// WithTelemetry sets the OpenTelemetry instrumentation of the connection.
+func (con *Connection) WithTelemetry(t *telemetry.Telemetry) *Connection {
+	con.telemetry = t
+	return con
+}
+
+// Telemetry returns the OpenTelemetry instrumentation used by the connection, it may be nil.
+func (con *Connection) Telemetry() *telemetry.Telemetry {
+	if con == nil {
+		return nil
+	}
+	return con.telemetry
+}
+
 func (con *Connection) WaitForWeaviate(timeout time.Duration) error {
 	if timeout == 0 {
 		return nil // Treat 0 as "do not wait".
@@ -67,7 +196,7 @@ func (con *Connection) WaitForWeaviate(timeout time.Duration) error {
 			return nil
 		}
 
-		log.Printf("Weaviate not yet up. Waiting for another %s.", time.Second)
+		con.Logger().Info("Weaviate not yet up, waiting", "retry_in", time.Second, "url", con.basePath)
 
 		select {
 		case <-ctx.Done():
@@ -78,57 +207,6 @@ func (con *Connection) WaitForWeaviate(timeout time.Duration) error {
 	}
 }
 
-// startRefreshGoroutine starts a background goroutine that periodically refreshes the auth token.
-// The oauth2 package only refreshes the Tokens on new http requests => if there is no request for the lifetime of
-// the refresh token the client will become de-authenticated without this.
-func (con *Connection) startRefreshGoroutine(transport *oauth2.Transport) {
-	token, err := transport.Source.Token()
-	if err != nil {
-		log.Printf("Error during token refresh, getting token: %v", err)
-		return
-	}
-
-	if time.Until(token.Expiry) < 0 {
-		log.Printf("Requested token is expired")
-		return
-	}
-
-	// there is no point in manual refreshing if there is no refresh token. Note that this is the default with client
-	// credentials
-	if token.RefreshToken == "" {
-		return
-	}
-
-	go func() {
-		// initial sleep before requesting a token
-		timeToSleep := time.Until(token.Expiry) - time.Second*10
-		if timeToSleep > 0 {
-			time.Sleep(timeToSleep)
-		}
-		for {
-			select {
-			case <-con.doneCh:
-				return
-			default:
-				token, err = transport.Source.Token()
-				if token == nil || time.Until(token.Expiry) < 0 {
-					log.Printf("Requested token is expired. Stop requesting new access token.")
-					return
-				}
-				if err != nil {
-					log.Printf("Error during token refresh, getting token: %v", err)
-					time.Sleep(time.Second)
-				} else {
-					timeToSleep = time.Until(token.Expiry) - time.Second*10
-					if timeToSleep > 0 {
-						time.Sleep(timeToSleep)
-					}
-				}
-			}
-		}
-	}()
-}
-
 func (con *Connection) addHeaderToRequest(request *http.Request) {
 	for k, v := range con.headers {
 		request.Header.Add(k, v)
@@ -137,33 +215,25 @@ func (con *Connection) addHeaderToRequest(request *http.Request) {
 	request.Header.Set("Accept", "application/json")
 }
 
-func (con *Connection) marshalBody(body interface{}) (io.Reader, error) {
+func (con *Connection) marshalBody(body interface{}) ([]byte, error) {
 	if body == nil {
 		return nil, nil
 	}
-	jsonBody, err := json.Marshal(body) // Create the JSON body
-	if err != nil {
-		return nil, err
-	}
-	return bytes.NewBuffer(jsonBody), nil
+	return json.Marshal(body) // Create the JSON body
 }
 
-func (con *Connection) createRequest(ctx context.Context, path string,
-	restMethod string, body interface{},
+func (con *Connection) createRequest(ctx context.Context, url string,
+	restMethod string, body []byte,
 ) (*http.Request, error) {
-	url := con.basePath + path // Create the URL
-
-	jsonBody, err := con.marshalBody(body)
-	if err != nil {
-		return nil, err
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
 	}
-
-	request, err := http.NewRequest(restMethod, url, jsonBody)
+	request, err := http.NewRequestWithContext(ctx, restMethod, url, reader)
 	if err != nil {
 		return nil, err
 	}
 	con.addHeaderToRequest(request)
-	request = request.WithContext(ctx)
 	return request, nil
 }
 
@@ -174,56 +244,134 @@ func (con *Connection) createRequest(ctx context.Context, path string,
 //
 //	a response that may be parsed into a struct after the fact
 //	error if there was a network issue
+//
+// Requests are retried according to the retry policy of the connection, see [Connection.WithRetry].
 func (con *Connection) RunREST(ctx context.Context, path string,
 	restMethod string, requestBody interface{},
 ) (*ResponseData, error) {
-	request, requestErr := con.createRequest(ctx, path, restMethod, requestBody)
-	if requestErr != nil {
-		return nil, requestErr
-	}
-	response, responseErr := con.httpClient.Do(request)
-	if responseErr != nil {
-		return nil, responseErr
-	}
+	return con.run(ctx, path, false, restMethod, requestBody)
+}
 
-	defer response.Body.Close()
-	body, bodyErr := io.ReadAll(response.Body)
-	if bodyErr != nil {
-		return nil, bodyErr
+// RunRESTExternal executes a http request against hostAndPath, which is not relative to the Weaviate base path.
+func (con *Connection) RunRESTExternal(ctx context.Context, hostAndPath string, restMethod string, requestBody interface{}) (*ResponseData, error) {
+	return con.run(ctx, hostAndPath, true, restMethod, requestBody)
+}
+
+// Close waits for in-flight requests to finish and stops the token refresh goroutine.
+// Requests made after Close return fault.ErrClientClosed.
+func (con *Connection) Close() error {
+	con.mutex.Lock()
+	if con.closed {
+		con.mutex.Unlock()
+		return nil
 	}
+	con.closed = true
+	con.mutex.Unlock()
 
-	return &ResponseData{
-		Body:       body,
-		StatusCode: response.StatusCode,
-	}, nil
+	con.inFlight.Wait()
+	con.pool.stopProbes()
+	con.refresher.stop()
+	return nil
 }
 
-func (con *Connection) RunRESTExternal(ctx context.Context, hostAndPath string, restMethod string, requestBody interface{}) (*ResponseData, error) {
-	jsonBody, err := con.marshalBody(requestBody)
-	if err != nil {
-		return nil, err
+// acquire registers an in-flight request, it returns false if the connection is closed.
+func (con *Connection) acquire() bool {
+	con.mutex.RLock()
+	defer con.mutex.RUnlock()
+	if con.closed {
+		return false
+	}
+	con.inFlight.Add(1)
+	return true
+}
+
+// run sends the request to path on one of the nodes of the cluster, or to path as is if it is external.
+func (con *Connection) run(ctx context.Context, path string, external bool, restMethod string, requestBody interface{}) (*ResponseData, error) {
+	if !con.acquire() {
+		return nil, fault.ErrClientClosed
 	}
+	defer con.inFlight.Done()
 
-	request, err := http.NewRequest(restMethod, hostAndPath, jsonBody)
+	body, err := con.marshalBody(requestBody)
 	if err != nil {
 		return nil, err
 	}
-	con.addHeaderToRequest(request)
-	request = request.WithContext(ctx)
-	response, responseErr := con.httpClient.Do(request)
+
+	attempts := 1
+	if isIdempotent(restMethod) || isRetryNonIdempotent(ctx) {
+		attempts = con.retry.Attempts()
+	}
+	readOnly := isReadOnly(ctx, restMethod)
+	for attempt := 1; ; attempt++ {
+		var responseData *ResponseData
+		var responseErr error
+		if external {
+			responseData, responseErr = con.do(ctx, path, restMethod, body)
+		} else {
+			// every attempt may go to a different node
+			node := con.pool.pick(readOnly)
+			node.inFlight.Add(1)
+			responseData, responseErr = con.do(ctx, node.basePath+path, restMethod, body)
+			node.inFlight.Add(-1)
+			con.pool.report(node, ctx.Err() == nil && isNodeFailure(responseData, responseErr))
+		}
+		if attempt >= attempts {
+			return responseData, responseErr
+		}
+
+		var delay time.Duration
+		switch {
+		case responseErr != nil:
+			if ctx.Err() != nil || !con.retry.IsRetryableError(responseErr) {
+				return nil, responseErr
+			}
+			delay = con.retry.Backoff(attempt)
+		case con.retry.IsRetryableStatusCode(responseData.StatusCode):
+			delay = con.retry.RetryAfterDelay(responseData.Header.Get("Retry-After"), attempt)
+		default:
+			return responseData, nil
+		}
+
+		if retry.Wait(ctx, delay) != nil {
+			// the context expires before the next attempt could be made, return the last result
+			return responseData, responseErr
+		}
+	}
+}
+
+func (con *Connection) do(ctx context.Context, url string, restMethod string, body []byte) (*ResponseData, error) {
+	ctx, op := con.telemetry.StartRequest(ctx, restMethod, telemetry.Method(restMethod))
+	responseData, err := con.send(ctx, op, url, restMethod, body)
+	op.End(err)
+	return responseData, err
+}
+
+func (con *Connection) send(ctx context.Context, op *telemetry.Operation, url string, restMethod string, body []byte) (*ResponseData, error) {
+	request, requestErr := con.createRequest(ctx, url, restMethod, body)
+	if requestErr != nil {
+		return nil, requestErr
+	}
+	op.SetAttributes(telemetry.Path(request.URL.Path))
+	con.telemetry.Inject(ctx, propagation.HeaderCarrier(request.Header))
+
+	start := time.Now()
+	response, responseErr := con.handler(request)
+	con.logRequest(ctx, request, response, responseErr, time.Since(start))
 	if responseErr != nil {
 		return nil, responseErr
 	}
+	op.SetAttributes(telemetry.StatusCode(response.StatusCode))
 
 	defer response.Body.Close()
-	body, bodyErr := io.ReadAll(response.Body)
+	responseBody, bodyErr := io.ReadAll(response.Body)
 	if bodyErr != nil {
 		return nil, bodyErr
 	}
 
 	return &ResponseData{
-		Body:       body,
+		Body:       responseBody,
 		StatusCode: response.StatusCode,
+		Header:     response.Header,
 	}, nil
 }
 
@@ -231,6 +379,7 @@ func (con *Connection) RunRESTExternal(ctx context.Context, hostAndPath string,
 type ResponseData struct {
 	Body       []byte
 	StatusCode int
+	Header     http.Header
 }
 
 // DecodeBodyIntoTarget unmarshall body into target var
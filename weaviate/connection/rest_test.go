@@ -0,0 +1,284 @@
+package connection
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/fault"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/loadbalance"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/retry"
+	"golang.org/x/oauth2"
+)
+
+func newTestConnection(t *testing.T, handler http.HandlerFunc) *Connection {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+}
+
+// failingHandler responds with statusCode to the first `failures` requests and with 200 afterwards.
+func failingHandler(calls *atomic.Int32, failures int32, statusCode int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(statusCode)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func TestRunREST_Retry(t *testing.T) {
+	config := &retry.Config{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	t.Run("retries idempotent requests", func(t *testing.T) {
+		var calls atomic.Int32
+		con := newTestConnection(t, failingHandler(&calls, 2, http.StatusServiceUnavailable)).WithRetry(config)
+
+		res, err := con.RunREST(context.Background(), "/objects", http.MethodGet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		var calls atomic.Int32
+		con := newTestConnection(t, failingHandler(&calls, 5, http.StatusTooManyRequests)).WithRetry(config)
+
+		res, err := con.RunREST(context.Background(), "/objects", http.MethodGet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("does not retry non retryable status codes", func(t *testing.T) {
+		var calls atomic.Int32
+		con := newTestConnection(t, failingHandler(&calls, 5, http.StatusInternalServerError)).WithRetry(config)
+
+		res, err := con.RunREST(context.Background(), "/objects", http.MethodGet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("does not retry non idempotent requests", func(t *testing.T) {
+		var calls atomic.Int32
+		con := newTestConnection(t, failingHandler(&calls, 2, http.StatusServiceUnavailable)).WithRetry(config)
+
+		res, err := con.RunREST(context.Background(), "/objects", http.MethodPost, map[string]string{"class": "Test"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("retries non idempotent requests if allowed", func(t *testing.T) {
+		var calls atomic.Int32
+		con := newTestConnection(t, failingHandler(&calls, 2, http.StatusServiceUnavailable)).WithRetry(config)
+
+		ctx := WithRetryNonIdempotent(context.Background())
+		res, err := con.RunREST(ctx, "/graphql", http.MethodPost, map[string]string{"query": "{}"})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("does not retry without retry config", func(t *testing.T) {
+		var calls atomic.Int32
+		con := newTestConnection(t, failingHandler(&calls, 2, http.StatusServiceUnavailable))
+
+		res, err := con.RunREST(context.Background(), "/objects", http.MethodGet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("stops when the context deadline is shorter than the backoff", func(t *testing.T) {
+		var calls atomic.Int32
+		con := newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Retry-After", "10")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}).WithRetry(config)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		res, err := con.RunREST(ctx, "/objects", http.MethodGet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestRunREST_Interceptors(t *testing.T) {
+	var requestIDs []string
+	con := newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-Id"))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	var order []string
+	var statusCode int
+	con.WithInterceptors(
+		func(request *http.Request, next RESTHandler) (*http.Response, error) {
+			order = append(order, "outer")
+			response, err := next(request)
+			if err == nil {
+				statusCode = response.StatusCode
+			}
+			return response, err
+		},
+		func(request *http.Request, next RESTHandler) (*http.Response, error) {
+			order = append(order, "inner")
+			request.Header.Set("X-Request-Id", "request-1")
+			return next(request)
+		},
+	)
+
+	_, err := con.RunREST(context.Background(), "/meta", http.MethodGet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"outer", "inner"}, order)
+	assert.Equal(t, []string{"request-1"}, requestIDs)
+	assert.Equal(t, http.StatusOK, statusCode)
+}
+
+func TestRunREST_DebugLogging(t *testing.T) {
+	con := newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	con.headers = map[string]string{
+		"Authorization":    "Bearer secret-token",
+		"X-OpenAI-Api-Key": "sk-secret",
+		"X-Request-Id":     "request-1",
+	}
+
+	var buf bytes.Buffer
+	con.WithLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	_, err := con.RunREST(context.Background(), "/meta", http.MethodGet, nil)
+	require.NoError(t, err)
+	output := buf.String()
+	assert.Contains(t, output, "status_code=200")
+	assert.Contains(t, output, "headers.X-Request-Id=request-1")
+	assert.NotContains(t, output, "secret")
+	assert.Contains(t, output, redacted)
+}
+
+func TestNewConnectionWithOptions_LogsTokenCheck(t *testing.T) {
+	var buf bytes.Buffer
+	expired := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token", Expiry: time.Now().Add(-time.Minute)})
+	con := NewConnectionWithOptions(ConnectionOptions{
+		Scheme:     "http",
+		Host:       "localhost:8080",
+		HTTPClient: &http.Client{Transport: &oauth2.Transport{Source: expired}},
+		Logger:     slog.New(slog.NewTextHandler(&buf, nil)),
+	})
+	defer con.Close()
+	assert.Contains(t, buf.String(), "Requested token is expired")
+}
+
+func TestConnection_Close(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	con := newTestConnection(t, func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.WriteHeader(http.StatusOK)
+	})
+
+	inFlight := make(chan error)
+	go func() {
+		_, err := con.RunREST(context.Background(), "/meta", http.MethodGet, nil)
+		inFlight <- err
+	}()
+	<-started
+
+	closed := make(chan error)
+	go func() { closed <- con.Close() }()
+	select {
+	case <-closed:
+		t.Fatal("Close returned before the in-flight request finished")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-inFlight)
+	require.NoError(t, <-closed)
+
+	_, err := con.RunREST(context.Background(), "/meta", http.MethodGet, nil)
+	assert.ErrorIs(t, err, fault.ErrClientClosed)
+	assert.NoError(t, con.Close())
+}
+
+func TestRunREST_LoadBalancing(t *testing.T) {
+	newNode := func(t *testing.T, calls *atomic.Int32, statusCode int) string {
+		t.Helper()
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/.well-known/ready" {
+				calls.Add(1)
+			}
+			w.WriteHeader(statusCode)
+		}))
+		t.Cleanup(server.Close)
+		return strings.TrimPrefix(server.URL, "http://")
+	}
+
+	t.Run("spreads reads and sends writes to the first node", func(t *testing.T) {
+		var first, second atomic.Int32
+		con := NewConnection("http", newNode(t, &first, http.StatusOK), nil, time.Second, nil).
+			WithLoadBalancing(&loadbalance.Config{ProbeInterval: time.Hour}, newNode(t, &second, http.StatusOK))
+		t.Cleanup(func() { con.Close() })
+
+		for range 4 {
+			_, err := con.RunREST(context.Background(), "/objects", http.MethodGet, nil)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), first.Load())
+		assert.Equal(t, int32(2), second.Load())
+
+		_, err := con.RunREST(context.Background(), "/objects", http.MethodPost, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), first.Load())
+	})
+
+	t.Run("ejects failing nodes", func(t *testing.T) {
+		var healthy, failing atomic.Int32
+		con := NewConnection("http", newNode(t, &failing, http.StatusServiceUnavailable), nil, time.Second, nil).
+			WithLoadBalancing(&loadbalance.Config{ProbeInterval: time.Hour, FailureThreshold: 2}, newNode(t, &healthy, http.StatusOK))
+		t.Cleanup(func() { con.Close() })
+
+		for range 10 {
+			_, err := con.RunREST(context.Background(), "/objects", http.MethodGet, nil)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(2), failing.Load())
+		assert.Equal(t, int32(8), healthy.Load())
+	})
+}
+
+func TestConnection_StopsProbesWhenCollected(t *testing.T) {
+	pool := func() *endpointPool {
+		con := NewConnection("http", "localhost:1", nil, time.Second, nil).
+			WithLoadBalancing(&loadbalance.Config{ProbeInterval: time.Millisecond}, "localhost:2")
+		return con.pool
+	}()
+
+	require.Eventually(t, func() bool {
+		runtime.GC()
+		select {
+		case <-pool.stopped:
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,29 @@
+package connection
+
+import (
+	"context"
+	"net/http"
+)
+
+type retryNonIdempotentKey struct{}
+
+// WithRetryNonIdempotent returns a context which allows requests with a non-idempotent
+// HTTP method (e.g. POST) to be retried. Builders use it for requests which are safe to
+// repeat, such as GraphQL queries or batch upserts of objects with known IDs.
+func WithRetryNonIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryNonIdempotentKey{}, true)
+}
+
+func isRetryNonIdempotent(ctx context.Context) bool {
+	allowed, _ := ctx.Value(retryNonIdempotentKey{}).(bool)
+	return allowed
+}
+
+func isIdempotent(restMethod string) bool {
+	switch restMethod {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
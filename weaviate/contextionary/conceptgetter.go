@@ -24,6 +24,13 @@ func (cg *ConceptGetter) WithConcept(concept string) *ConceptGetter {
 
 // Do get the concept
 func (cg *ConceptGetter) Do(ctx context.Context) (*models.C11yWordsResponse, error) {
+	ctx, op := cg.connection.Telemetry().Start(ctx, "contextionary.ConceptGetter")
+	result, err := cg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (cg *ConceptGetter) do(ctx context.Context) (*models.C11yWordsResponse, error) {
 	path := fmt.Sprintf("/modules/text2vec-contextionary/concepts/%v", cg.concept)
 	responseData, responseErr := cg.connection.RunREST(ctx, path, http.MethodGet, nil)
 	err := except.CheckResponseDataErrorAndStatusCode(responseData, responseErr, 200)
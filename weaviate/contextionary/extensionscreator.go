@@ -36,6 +36,13 @@ func (ec *ExtensionCreator) WithWeight(weight float32) *ExtensionCreator {
 
 // Do create the concept
 func (ec *ExtensionCreator) Do(ctx context.Context) error {
+	ctx, op := ec.connection.Telemetry().Start(ctx, "contextionary.ExtensionCreator")
+	err := ec.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (ec *ExtensionCreator) do(ctx context.Context) error {
 	if ec.extension.Weight > 1.0 || ec.extension.Weight < 0.0 {
 		return fmt.Errorf("weight must be between 0.0 and 1.0")
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // Checker builder to check data object's existence
@@ -40,6 +41,14 @@ func (c *Checker) WithTenant(tenant string) *Checker {
 
 // Do check the specified data object if it exists in weaviate
 func (checker *Checker) Do(ctx context.Context) (bool, error) {
+	ctx, op := checker.connection.Telemetry().Start(ctx, "data.Checker",
+		telemetry.Collection(checker.className), telemetry.Tenant(checker.tenant))
+	result, err := checker.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (checker *Checker) do(ctx context.Context) (bool, error) {
 	responseData, err := checker.connection.RunREST(ctx, checker.buildPath(), http.MethodHead, nil)
 	exists := responseData.StatusCode == 204
 	return exists, except.CheckResponseDataErrorAndStatusCode(responseData, err, 204, 404)
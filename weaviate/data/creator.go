@@ -9,6 +9,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -74,6 +75,14 @@ func (c *Creator) WithTenant(tenant string) *Creator {
 
 // Do create the data object as specified in the builder
 func (creator *Creator) Do(ctx context.Context) (*ObjectWrapper, error) {
+	ctx, op := creator.connection.Telemetry().Start(ctx, "data.Creator",
+		telemetry.Collection(creator.className), telemetry.Tenant(creator.tenant), telemetry.ConsistencyLevel(creator.consistencyLevel))
+	result, err := creator.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (creator *Creator) do(ctx context.Context) (*ObjectWrapper, error) {
 	var err error
 	var responseData *connection.ResponseData
 	object, _ := creator.PayloadObject()
@@ -8,6 +8,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // Deleter builder to delete a data object
@@ -48,6 +49,14 @@ func (d *Deleter) WithTenant(tenant string) *Deleter {
 
 // Do delete the specified data object from weaviate
 func (deleter *Deleter) Do(ctx context.Context) error {
+	ctx, op := deleter.connection.Telemetry().Start(ctx, "data.Deleter",
+		telemetry.Collection(deleter.className), telemetry.Tenant(deleter.tenant), telemetry.ConsistencyLevel(deleter.consistencyLevel))
+	err := deleter.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (deleter *Deleter) do(ctx context.Context) error {
 	path := pathbuilder.ObjectsDelete(pathbuilder.Components{
 		ID:               deleter.id,
 		Class:            deleter.className,
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"iter"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -11,9 +12,13 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
+// defaultPageSize is the number of objects ObjectsGetter.All fetches in one request
+const defaultPageSize = 100
+
 // ObjectsGetter Builder to retrieve Things from weaviate
 type ObjectsGetter struct {
 	connection           *connection.Connection
@@ -101,6 +106,43 @@ func (getter *ObjectsGetter) WithNodeName(name string) *ObjectsGetter {
 
 // Do get the data object
 func (getter *ObjectsGetter) Do(ctx context.Context) ([]*models.Object, error) {
+	ctx, op := getter.connection.Telemetry().Start(ctx, "data.ObjectsGetter",
+		telemetry.Collection(getter.className), telemetry.Tenant(getter.tenant), telemetry.ConsistencyLevel(getter.consistencyLevel))
+	result, err := getter.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+// All returns an iterator over all objects of the class, which fetches the pages
+// lazily with the after cursor. The limit set with WithLimit is the page size,
+// 100 by default. Iterating stops at the first error.
+func (getter *ObjectsGetter) All(ctx context.Context) iter.Seq2[*models.Object, error] {
+	return func(yield func(*models.Object, error) bool) {
+		// page on a copy, so that the builder can be iterated again
+		page := *getter
+		if !page.withLimit {
+			page.WithLimit(defaultPageSize)
+		}
+		for {
+			objects, err := page.Do(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, object := range objects {
+				if !yield(object, nil) {
+					return
+				}
+			}
+			if len(objects) < page.limit || len(objects) == 0 {
+				return
+			}
+			page.after = objects[len(objects)-1].ID.String()
+		}
+	}
+}
+
+func (getter *ObjectsGetter) do(ctx context.Context) ([]*models.Object, error) {
 	responseData, err := getter.objectList(ctx)
 	if err != nil {
 		return nil, err
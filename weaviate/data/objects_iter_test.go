@@ -0,0 +1,53 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestObjectsGetter_All(t *testing.T) {
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		after, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Query().Get("after"), "00000000-0000-0000-0000-"))
+		afters = append(afters, r.URL.Query().Get("after"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var objects []*models.Object
+		for i := after + 1; i <= min(after+limit, 5); i++ {
+			objects = append(objects, &models.Object{ID: strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))})
+		}
+		json.NewEncoder(w).Encode(models.ObjectsListResponse{Objects: objects})
+	}))
+	defer server.Close()
+	con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+	getter := New(con, newDBVersionSupportForTests("1.30.0")).ObjectsGetter().WithClassName("Article").WithLimit(2)
+
+	var ids []string
+	for object, err := range getter.All(context.Background()) {
+		require.NoError(t, err)
+		ids = append(ids, object.ID.String())
+	}
+	assert.Len(t, ids, 5)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000005", ids[4])
+	assert.Equal(t, []string{"", "00000000-0000-0000-0000-000000000002", "00000000-0000-0000-0000-000000000004"}, afters)
+
+	t.Run("stops when the loop breaks", func(t *testing.T) {
+		afters = nil
+		for range getter.All(context.Background()) {
+			break
+		}
+		assert.Len(t, afters, 1)
+	})
+}
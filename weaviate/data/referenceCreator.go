@@ -8,6 +8,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -64,6 +65,14 @@ func (rc *ReferenceCreator) WithConsistencyLevel(cl string) *ReferenceCreator {
 
 // Do add the reference specified by the set payload to the object and property specified in the builder.
 func (rc *ReferenceCreator) Do(ctx context.Context) error {
+	ctx, op := rc.connection.Telemetry().Start(ctx, "data.ReferenceCreator",
+		telemetry.Collection(rc.className), telemetry.Tenant(rc.tenant), telemetry.ConsistencyLevel(rc.consistencyLevel))
+	err := rc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (rc *ReferenceCreator) do(ctx context.Context) error {
 	path := pathbuilder.References(pathbuilder.Components{
 		ID:                rc.uuid,
 		Class:             rc.className,
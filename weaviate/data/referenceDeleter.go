@@ -8,6 +8,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -63,6 +64,14 @@ func (rd *ReferenceDeleter) WithTenant(tenant string) *ReferenceDeleter {
 
 // Do remove the reference defined by the payload set in this builder to the property and object defined in this builder
 func (rd *ReferenceDeleter) Do(ctx context.Context) error {
+	ctx, op := rd.connection.Telemetry().Start(ctx, "data.ReferenceDeleter",
+		telemetry.Collection(rd.className), telemetry.Tenant(rd.tenant), telemetry.ConsistencyLevel(rd.consistencyLevel))
+	err := rd.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (rd *ReferenceDeleter) do(ctx context.Context) error {
 	path := pathbuilder.References(pathbuilder.Components{
 		ID:                rd.uuid,
 		Class:             rd.className,
@@ -8,6 +8,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -63,6 +64,14 @@ func (rr *ReferenceReplacer) WithTenant(tenant string) *ReferenceReplacer {
 
 // Do replace the references of the in this builder specified data object
 func (rr *ReferenceReplacer) Do(ctx context.Context) error {
+	ctx, op := rr.connection.Telemetry().Start(ctx, "data.ReferenceReplacer",
+		telemetry.Collection(rr.className), telemetry.Tenant(rr.tenant), telemetry.ConsistencyLevel(rr.consistencyLevel))
+	err := rr.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (rr *ReferenceReplacer) do(ctx context.Context) error {
 	path := pathbuilder.References(pathbuilder.Components{
 		ID:                rr.uuid,
 		Class:             rr.className,
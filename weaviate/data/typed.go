@@ -0,0 +1,43 @@
+package data
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/typed"
+)
+
+// CreateTyped creates value, a struct mapped with `weaviate` tags as described in
+// package typed, with the creator and returns the created object as a T. The ID
+// and vectors of the object are taken from value, if set.
+func CreateTyped[T any](ctx context.Context, creator *Creator, value T) (T, error) {
+	var created T
+	object, err := typed.ToObject(creator.className, value)
+	if err != nil {
+		return created, err
+	}
+	creator.WithProperties(object.Properties)
+	if object.ID != "" {
+		creator.WithID(object.ID.String())
+	}
+	if len(object.Vector) > 0 {
+		creator.WithVector(object.Vector)
+	}
+	if len(object.Vectors) > 0 {
+		creator.WithVectors(object.Vectors)
+	}
+	result, err := creator.Do(ctx)
+	if err != nil {
+		return created, err
+	}
+	return typed.FromObject[T](result.Object)
+}
+
+// GetTyped returns the objects matched by the getter as Ts, structs mapped with
+// `weaviate` tags as described in package typed
+func GetTyped[T any](ctx context.Context, getter *ObjectsGetter) ([]T, error) {
+	objects, err := getter.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return typed.FromObjects[T](objects)
+}
@@ -0,0 +1,50 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type typedArticle struct {
+	ID        strfmt.UUID `weaviate:",id"`
+	Title     string      `weaviate:"title"`
+	Published time.Time   `weaviate:"published"`
+	Vector    []float32   `weaviate:"title_vector,vector"`
+}
+
+func TestCreateTyped(t *testing.T) {
+	var received models.Object
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		created := received
+		created.ID = "00000000-0000-0000-0000-000000000001"
+		json.NewEncoder(w).Encode(created)
+	}))
+	defer server.Close()
+	con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+
+	article := typedArticle{
+		Title:     "A",
+		Published: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Vector:    []float32{1, 2},
+	}
+	created, err := CreateTyped(context.Background(), New(con, nil).Creator().WithClassName("Article"), article)
+	require.NoError(t, err)
+	assert.Equal(t, "Article", received.Class)
+	assert.Empty(t, received.ID)
+	assert.Equal(t, map[string]interface{}{"title": "A", "published": "2024-01-02T00:00:00Z"}, received.Properties)
+
+	article.ID = "00000000-0000-0000-0000-000000000001"
+	assert.Equal(t, article, created)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/pathbuilder"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -82,6 +83,14 @@ func (u *Updater) WithTenant(tenant string) *Updater {
 
 // Do update the data object specified in the builder
 func (updater *Updater) Do(ctx context.Context) error {
+	ctx, op := updater.connection.Telemetry().Start(ctx, "data.Updater",
+		telemetry.Collection(updater.className), telemetry.Tenant(updater.tenant), telemetry.ConsistencyLevel(updater.consistencyLevel))
+	err := updater.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (updater *Updater) do(ctx context.Context) error {
 	path := pathbuilder.ObjectsUpdate(pathbuilder.Components{
 		ID:               updater.id,
 		Class:            updater.className,
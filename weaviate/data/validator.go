@@ -7,6 +7,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -39,12 +40,20 @@ func (validator *Validator) WithProperties(propertySchema models.PropertySchema)
 // Do validate the data object specified in the builder
 // Will return an error if the object is not valid or if there is a different error
 func (validator *Validator) Do(ctx context.Context) error {
+	ctx, op := validator.connection.Telemetry().Start(ctx, "data.Validator",
+		telemetry.Collection(validator.className))
+	err := validator.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (validator *Validator) do(ctx context.Context) error {
 	path := "/objects/validate"
 	object := models.Object{
 		Class:      validator.className,
 		ID:         strfmt.UUID(validator.uuid),
 		Properties: validator.propertySchema,
 	}
-	responseData, err := validator.connection.RunREST(ctx, path, http.MethodPost, object)
+	responseData, err := validator.connection.RunREST(connection.WithRetryNonIdempotent(ctx), path, http.MethodPost, object)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
 }
@@ -1,7 +1,7 @@
 package db
 
 import (
-	"fmt"
+	"log/slog"
 	"strconv"
 	"strings"
 )
@@ -12,10 +12,25 @@ type versionProvider interface {
 
 type VersionSupport struct {
 	dbVersionProvider versionProvider
+	logger            *slog.Logger
 }
 
 func NewDBVersionSupport(dbVersionProvider versionProvider) *VersionSupport {
-	return &VersionSupport{dbVersionProvider}
+	return &VersionSupport{dbVersionProvider: dbVersionProvider}
+}
+
+// WithLogger sets the logger used for deprecation warnings. If logger is nil, slog.Default() is used.
+func (v *VersionSupport) WithLogger(logger *slog.Logger) *VersionSupport {
+	v.logger = logger
+	return v
+}
+
+func (v *VersionSupport) warn(msg string) {
+	logger := v.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.Warn(msg, "version", v.dbVersionProvider.Version())
 }
 
 func (v *VersionSupport) SupportsClassNameNamespacedEndpoints() bool {
@@ -38,36 +53,29 @@ func (v *VersionSupport) SupportsClassNameNamespacedEndpoints() bool {
 }
 
 func (v *VersionSupport) WarnDeprecatedNonClassNameNamespacedEndpointsForObjects() {
-	fmt.Printf("WARNING: Usage of objects paths without className is deprecated in Weaviate %v."+
-		" Please provide className parameter\n", v.dbVersionProvider.Version())
+	v.warn("Usage of objects paths without className is deprecated. Please provide className parameter")
 }
 
 func (v *VersionSupport) WarnDeprecatedNonClassNameNamespacedEndpointsForReferences() {
-	fmt.Printf("WARNING: Usage of references paths without className is deprecated in Weaviate %v."+
-		" Please provide className parameter\n", v.dbVersionProvider.Version())
+	v.warn("Usage of references paths without className is deprecated. Please provide className parameter")
 }
 
 func (v *VersionSupport) WarnDeprecatedNonClassNameNamespacedEndpointsForBeacons() {
-	fmt.Printf("WARNING: Usage of beacon paths without className is deprecated in Weaviate %v."+
-		" Please provide className parameter\n", v.dbVersionProvider.Version())
+	v.warn("Usage of beacon paths without className is deprecated. Please provide className parameter")
 }
 
 func (v *VersionSupport) WarnUsageOfNotSupportedClassNamespacedEndpointsForObjects() {
-	fmt.Printf("WARNING: Usage of objects paths with className is not supported in Weaviate %v."+
-		" className parameter is ignored\n", v.dbVersionProvider.Version())
+	v.warn("Usage of objects paths with className is not supported. className parameter is ignored")
 }
 
 func (v *VersionSupport) WarnUsageOfNotSupportedClassNamespacedEndpointsForReferences() {
-	fmt.Printf("WARNING: Usage of references paths with className is not supported in Weaviate %v."+
-		" className parameter is ignored\n", v.dbVersionProvider.Version())
+	v.warn("Usage of references paths with className is not supported. className parameter is ignored")
 }
 
 func (v *VersionSupport) WarnUsageOfNotSupportedClassNamespacedEndpointsForBeacons() {
-	fmt.Printf("WARNING: Usage of beacons paths with className is not supported in Weaviate %v."+
-		" className parameter is ignored\n", v.dbVersionProvider.Version())
+	v.warn("Usage of beacons paths with className is not supported. className parameter is ignored")
 }
 
 func (v *VersionSupport) WarnNotSupportedClassParameterInEndpointsForObjects() {
-	fmt.Printf("WARNING: Usage of objects paths with class query parameter is not supported in Weaviate %v."+
-		" class query parameter is ignored\n", v.dbVersionProvider.Version())
+	v.warn("Usage of objects paths with class query parameter is not supported. class query parameter is ignored")
 }
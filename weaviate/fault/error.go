@@ -1,9 +1,13 @@
 package fault
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrClientClosed is returned by requests made after the client was closed.
+var ErrClientClosed = errors.New("weaviate client is closed")
+
 // WeaviateClientError is returned if the client experienced an error.
 //
 //	If the error is due to weaviate returning an unexpected status code the IsUnexpectedStatusCode field will be true
@@ -29,3 +33,8 @@ func (uce *WeaviateClientError) Error() string {
 func (uce *WeaviateClientError) GoString() string {
 	return uce.Error()
 }
+
+// Unwrap returns the error the WeaviateClientError was derived from, if any.
+func (uce *WeaviateClientError) Unwrap() error {
+	return uce.DerivedFromError
+}
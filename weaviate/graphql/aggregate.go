@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -163,8 +164,18 @@ func (ab *AggregateBuilder) WithTenant(tenant string) *AggregateBuilder {
 }
 
 // Do execute the aggregation query
+// Errors of the GraphQL response are returned as ResponseErrors along with the response,
+// which may hold partial results.
 func (ab *AggregateBuilder) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, ab.connection, ab.build())
+	return runGraphQLQuery(ctx, ab.connection, ab.build(), "graphql.AggregateBuilder",
+		telemetry.Collection(ab.className), telemetry.Tenant(ab.tenant))
+}
+
+// DoWithResult executes the aggregation query and returns the response with accessors
+// of its results. Errors of the response are returned as ResponseErrors along with
+// the response, which may hold partial results.
+func (ab *AggregateBuilder) DoWithResult(ctx context.Context) (*Response, error) {
+	return newResponse(ab.Do(ctx))
 }
 
 func (ab *AggregateBuilder) createFilterClause() string {
@@ -160,6 +160,15 @@ func (e *Explore) build() string {
 }
 
 // Do execute explore search
+// Errors of the GraphQL response are returned as ResponseErrors along with the response,
+// which may hold partial results.
 func (e *Explore) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, e.connection, e.build())
+	return runGraphQLQuery(ctx, e.connection, e.build(), "graphql.Explore")
+}
+
+// DoWithResult executes explore search and returns the response with accessors of
+// its results. Errors of the response are returned as ResponseErrors along with the
+// response, which may hold partial results.
+func (e *Explore) DoWithResult(ctx context.Context) (*Response, error) {
+	return newResponse(e.Do(ctx))
 }
@@ -4,12 +4,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 )
 
 type GenerativeSearchBuilder struct {
 	prompt     string
 	task       string
 	properties []string
+	debug      bool
+	provider   *GenerativeProviderBuilder
 }
 
 func NewGenerativeSearch() *GenerativeSearchBuilder {
@@ -27,6 +31,57 @@ func (gsb *GenerativeSearchBuilder) GroupedResult(task string, properties ...str
 	return gsb
 }
 
+// WithProvider overrides the generative module of the collection and its settings.
+// Only used by the gRPC Search.
+func (gsb *GenerativeSearchBuilder) WithProvider(provider *GenerativeProviderBuilder) *GenerativeSearchBuilder {
+	gsb.provider = provider
+	return gsb
+}
+
+// WithDebug returns the full prompt sent to the generative module with the results.
+// Only used by the gRPC Search.
+func (gsb *GenerativeSearchBuilder) WithDebug() *GenerativeSearchBuilder {
+	gsb.debug = true
+	return gsb
+}
+
+// togrpc sets the fields of servers since v1.27 and, unless a provider or debug is used,
+// which older servers do not support, the deprecated fields read by older servers.
+// Servers since v1.27 ignore the deprecated fields.
+func (gsb *GenerativeSearchBuilder) togrpc() *pb.GenerativeSearch {
+	var queries []*pb.GenerativeProvider
+	if gsb.provider != nil {
+		queries = []*pb.GenerativeProvider{gsb.provider.togrpc()}
+	}
+	legacy := gsb.provider == nil && !gsb.debug
+	generative := &pb.GenerativeSearch{}
+	if gsb.prompt != "" {
+		generative.Single = &pb.GenerativeSearch_Single{
+			Prompt:  gsb.prompt,
+			Debug:   gsb.debug,
+			Queries: queries,
+		}
+		if legacy {
+			generative.SingleResponsePrompt = gsb.prompt
+		}
+	}
+	if gsb.task != "" || len(gsb.properties) > 0 {
+		generative.Grouped = &pb.GenerativeSearch_Grouped{
+			Task:    gsb.task,
+			Debug:   gsb.debug,
+			Queries: queries,
+		}
+		if len(gsb.properties) > 0 {
+			generative.Grouped.Properties = &pb.TextArray{Values: gsb.properties}
+		}
+		if legacy {
+			generative.GroupedResponseTask = gsb.task
+			generative.GroupedProperties = gsb.properties
+		}
+	}
+	return generative
+}
+
 func (gsb *GenerativeSearchBuilder) build() Field {
 	nameParts := []string{}
 	fieldNames := []string{}
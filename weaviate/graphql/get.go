@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -224,8 +225,18 @@ func (gb *GetBuilder) WithTenant(tenant string) *GetBuilder {
 }
 
 // Do execute the GraphQL query
+// Errors of the GraphQL response are returned as ResponseErrors along with the response,
+// which may hold partial results.
 func (gb *GetBuilder) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, gb.connection, gb.build())
+	return runGraphQLQuery(ctx, gb.connection, gb.build(), "graphql.GetBuilder",
+		telemetry.Collection(gb.className), telemetry.Tenant(gb.tenant), telemetry.ConsistencyLevel(gb.consistencyLevel))
+}
+
+// DoWithResult executes the GraphQL query and returns the response with accessors of
+// its results. Errors of the response are returned as ResponseErrors along with the
+// response, which may hold partial results.
+func (gb *GetBuilder) DoWithResult(ctx context.Context) (*Response, error) {
+	return newResponse(gb.Do(ctx))
 }
 
 // Build execute the GraphQL query
@@ -6,7 +6,9 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // API group for GraphQL
@@ -133,12 +135,33 @@ type rest interface {
 	RunREST(ctx context.Context, path string, restMethod string, requestBody interface{}) (*connection.ResponseData, error)
 }
 
-func runGraphQLQuery(ctx context.Context, rest rest, query string) (*models.GraphQLResponse, error) {
+// instrumented is implemented by connections which carry OpenTelemetry instrumentation
+type instrumented interface {
+	Telemetry() *telemetry.Telemetry
+}
+
+func runGraphQLQuery(ctx context.Context, rest rest, query string,
+	operation string, attrs ...attribute.KeyValue,
+) (*models.GraphQLResponse, error) {
+	var tel *telemetry.Telemetry
+	if i, ok := rest.(instrumented); ok {
+		tel = i.Telemetry()
+	}
+	ctx, op := tel.Start(ctx, operation, attrs...)
+	gqlResponse, err := doGraphQLQuery(ctx, rest, query)
+	if err == nil && len(gqlResponse.Errors) > 0 {
+		err = ResponseErrors(gqlResponse.Errors)
+	}
+	op.End(err)
+	return gqlResponse, err
+}
+
+func doGraphQLQuery(ctx context.Context, rest rest, query string) (*models.GraphQLResponse, error) {
 	// Do execute the GraphQL query
 	gqlQuery := models.GraphQLQuery{
 		Query: query,
 	}
-	responseData, responseErr := rest.RunREST(ctx, "/graphql", http.MethodPost, &gqlQuery)
+	responseData, responseErr := rest.RunREST(connection.WithReadOnly(connection.WithRetryNonIdempotent(ctx)), "/graphql", http.MethodPost, &gqlQuery)
 	err := except.CheckResponseDataErrorAndStatusCode(responseData, responseErr, 200)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 )
 
 type GroupByArgumentBuilder struct {
@@ -48,3 +50,14 @@ func (b *GroupByArgumentBuilder) build() string {
 	}
 	return fmt.Sprintf("groupBy:{%s}", strings.Join(clause, " "))
 }
+
+func (b *GroupByArgumentBuilder) togrpc() *pb.GroupBy {
+	groupBy := &pb.GroupBy{Path: b.path}
+	if b.withGroups {
+		groupBy.NumberOfGroups = int32(b.groups)
+	}
+	if b.withObjectsPerGroup {
+		groupBy.ObjectsPerGroup = int32(b.objectsPerGroup)
+	}
+	return groupBy
+}
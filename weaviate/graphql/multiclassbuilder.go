@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -25,20 +26,24 @@ func (mb *MultiClassBuilder) AddQueryClass(class *GetBuilder) *MultiClassBuilder
 }
 
 // Do execute the GraphQL query
+// Errors of the GraphQL response are returned as ResponseErrors along with the response,
+// which may hold partial results.
 func (mb *MultiClassBuilder) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, mb.connection, mb.build())
+	return runGraphQLQuery(ctx, mb.connection, mb.build(), "graphql.MultiClassBuilder",
+		telemetry.Collection(strings.Join(mb.classNames(), ",")))
+}
+
+// DoWithResult executes the GraphQL query and returns the response with accessors of
+// its results. Errors of the response are returned as ResponseErrors along with the
+// response, which may hold partial results.
+func (mb *MultiClassBuilder) DoWithResult(ctx context.Context) (*Response, error) {
+	return newResponse(mb.Do(ctx))
 }
 
 // build the GraphQL query string (not needed when Do is executed)
 func (mb *MultiClassBuilder) build() string {
 	var query string
-	// sorting className to have consistent order in query
-	s := make([]string, 0, len(mb.classBuilders))
-	for key := range mb.classBuilders {
-		s = append(s, key)
-	}
-	sort.Strings(s)
-	for _, className := range s {
+	for _, className := range mb.classNames() {
 		filterClause := ""
 		if mb.classBuilders[className].includesFilterClause {
 			filterClause = mb.classBuilders[className].createFilterClause()
@@ -50,3 +55,13 @@ func (mb *MultiClassBuilder) build() string {
 	query = fmt.Sprintf("{Get {%v}}", query)
 	return query
 }
+
+// classNames returns the sorted names of the queried classes, to have a consistent order in the query
+func (mb *MultiClassBuilder) classNames() []string {
+	names := make([]string, 0, len(mb.classBuilders))
+	for name := range mb.classBuilders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
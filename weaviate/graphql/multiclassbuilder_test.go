@@ -1,10 +1,20 @@
 package graphql
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestMultiClassQueryBuilder(t *testing.T) {
@@ -421,3 +431,32 @@ func TestMultiClassHybridBuilder(t *testing.T) {
 	expected := `{Get {Pizza (hybrid:{query: "query1", vector: [1,2,3], alpha: 0.6}) {} Risotto (hybrid:{query: "query2", vector: [4,5,6], alpha: 0.8}) {}}}`
 	assert.Equal(t, expected, query)
 }
+
+func TestMultiClassBuilder_Telemetry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": {"Get": {}}}`))
+	}))
+	defer server.Close()
+	recorder := tracetest.NewSpanRecorder()
+	tel, err := telemetry.New(&telemetry.Config{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+	})
+	require.NoError(t, err)
+	con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil).
+		WithTelemetry(tel)
+
+	_, err = New(con).MultiClassGet().
+		AddQueryClass(NewQueryClassBuilder("Risotto").WithFields(Field{Name: "name"})).
+		AddQueryClass(NewQueryClassBuilder("Pizza").WithFields(Field{Name: "name"})).
+		Do(context.Background())
+	require.NoError(t, err)
+
+	var found bool
+	for _, span := range recorder.Ended() {
+		if span.Name() == "graphql.MultiClassBuilder" {
+			found = true
+			assert.Contains(t, span.Attributes(), telemetry.Collection("Pizza,Risotto"))
+		}
+	}
+	assert.True(t, found)
+}
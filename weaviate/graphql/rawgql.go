@@ -13,8 +13,17 @@ type Raw struct {
 }
 
 // Do execute the GraphQL query
+// Errors of the GraphQL response are returned as ResponseErrors along with the response,
+// which may hold partial results.
 func (gql *Raw) Do(ctx context.Context) (*models.GraphQLResponse, error) {
-	return runGraphQLQuery(ctx, gql.connection, gql.build())
+	return runGraphQLQuery(ctx, gql.connection, gql.build(), "graphql.Raw")
+}
+
+// DoWithResult executes the GraphQL query and returns the response with accessors of
+// its results. Errors of the response are returned as ResponseErrors along with the
+// response, which may hold partial results.
+func (gql *Raw) DoWithResult(ctx context.Context) (*Response, error) {
+	return newResponse(gql.Do(ctx))
 }
 
 // WithQuery the query string
@@ -0,0 +1,395 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Response is a GraphQL response with typed accessors of its results
+type Response struct {
+	*models.GraphQLResponse
+}
+
+// ResponseErrors are the errors of a GraphQL response
+type ResponseErrors []*models.GraphQLError
+
+func (e ResponseErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		if err != nil {
+			messages = append(messages, err.Message)
+		}
+	}
+	return fmt.Sprintf("graphql: %s", strings.Join(messages, "; "))
+}
+
+// newResponse wraps the response of a query. The errors of the response are passed on
+// along with it, as it may hold partial results.
+func newResponse(response *models.GraphQLResponse, err error) (*Response, error) {
+	var responseErrors ResponseErrors
+	if err != nil && !errors.As(err, &responseErrors) {
+		return nil, err
+	}
+	return &Response{GraphQLResponse: response}, err
+}
+
+// ExploreResult is an object found by an Explore query
+type ExploreResult struct {
+	Beacon    string
+	ClassName string
+	Certainty float32
+	Distance  float32
+}
+
+// Objects returns the objects of a class of a Get query. The text generated for an object
+// with a single prompt of WithGenerativeSearch is set as its Generative.
+func (r *Response) Objects(className string) ([]SearchResult, error) {
+	items, err := r.getItems(className)
+	if err != nil {
+		return nil, err
+	}
+	return toSearchResults(className, items)
+}
+
+// Generative returns the text generated for all objects of a class of a Get query with
+// a grouped task of WithGenerativeSearch, or nil if there is none
+func (r *Response) Generative(className string) (*GenerativeResult, error) {
+	items, err := r.getItems(className)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		fields, _ := item.(map[string]any)
+		additional, _ := fields["_additional"].(map[string]any)
+		generate, _ := additional["generate"].(map[string]any)
+		if grouped, ok := generate["groupedResult"].(string); ok {
+			return &GenerativeResult{Text: grouped, Error: toString(generate["error"])}, nil
+		}
+	}
+	return nil, nil
+}
+
+// Groups returns the groups of a class of a Get query with WithGroupBy, one per object
+func (r *Response) Groups(className string) ([]GroupResult, error) {
+	items, err := r.getItems(className)
+	if err != nil {
+		return nil, err
+	}
+	var groups []GroupResult
+	for i, item := range items {
+		fields, _ := item.(map[string]any)
+		additional, _ := fields["_additional"].(map[string]any)
+		group, ok := additional["group"].(map[string]any)
+		if !ok {
+			continue
+		}
+		hits, _ := group["hits"].([]any)
+		objects, err := toSearchResults(className, hits)
+		if err != nil {
+			return nil, fmt.Errorf("get %s %d: group: %w", className, i, err)
+		}
+		groupedBy, _ := group["groupedBy"].(map[string]any)
+		groups = append(groups, GroupResult{
+			Name:            toGroupName(groupedBy["value"]),
+			MinDistance:     float32(toFloat64(group["minDistance"])),
+			MaxDistance:     float32(toFloat64(group["maxDistance"])),
+			NumberOfObjects: toInt64(group["count"]),
+			Objects:         objects,
+		})
+	}
+	return groups, nil
+}
+
+func (r *Response) getItems(className string) ([]any, error) {
+	get, _ := r.data()["Get"].(map[string]any)
+	items, ok := get[className].([]any)
+	if !ok && get[className] != nil {
+		return nil, fmt.Errorf("get %s: unexpected %T", className, get[className])
+	}
+	return items, nil
+}
+
+// Aggregations returns the aggregations of a class of an Aggregate query, one per
+// group if grouped by a property. Numeric aggregations are returned as Number, as
+// the response does not tell int and number properties apart.
+func (r *Response) Aggregations(className string) ([]AggregateGroup, error) {
+	aggregate, _ := r.data()["Aggregate"].(map[string]any)
+	items, ok := aggregate[className].([]any)
+	if !ok && aggregate[className] != nil {
+		return nil, fmt.Errorf("aggregate %s: unexpected %T", className, aggregate[className])
+	}
+	groups := make([]AggregateGroup, len(items))
+	for i, item := range items {
+		fields, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("aggregate %s %d: unexpected %T", className, i, item)
+		}
+		group := AggregateGroup{Properties: map[string]AggregationResult{}}
+		for name, value := range fields {
+			values, _ := value.(map[string]any)
+			switch name {
+			case "meta":
+				group.ObjectsCount = toInt64(values["count"])
+			case "groupedBy":
+				group.GroupedBy = GroupedBy{Path: toStrings(values["path"]), Value: values["value"]}
+			default:
+				aggregation, err := toAggregationResult(values)
+				if err != nil {
+					return nil, fmt.Errorf("aggregate %s %d: %s: %w", className, i, name, err)
+				}
+				group.Properties[name] = aggregation
+			}
+		}
+		groups[i] = group
+	}
+	return groups, nil
+}
+
+// Explore returns the objects found by an Explore query
+func (r *Response) Explore() ([]ExploreResult, error) {
+	items, ok := r.data()["Explore"].([]any)
+	if !ok && r.data()["Explore"] != nil {
+		return nil, fmt.Errorf("explore: unexpected %T", r.data()["Explore"])
+	}
+	results := make([]ExploreResult, len(items))
+	for i, item := range items {
+		fields, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("explore %d: unexpected %T", i, item)
+		}
+		results[i] = ExploreResult{
+			Beacon:    toString(fields["beacon"]),
+			ClassName: toString(fields["className"]),
+			Certainty: float32(toFloat64(fields["certainty"])),
+			Distance:  float32(toFloat64(fields["distance"])),
+		}
+	}
+	return results, nil
+}
+
+func (r *Response) data() map[string]models.JSONObject {
+	if r == nil || r.GraphQLResponse == nil {
+		return nil
+	}
+	return r.Data
+}
+
+func toSearchResults(className string, items []any) ([]SearchResult, error) {
+	results := make([]SearchResult, len(items))
+	for i, item := range items {
+		fields, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("get %s %d: unexpected %T", className, i, item)
+		}
+		results[i] = toSearchResult(className, fields)
+	}
+	return results, nil
+}
+
+func toSearchResult(className string, fields map[string]any) SearchResult {
+	result := SearchResult{Collection: className, Properties: make(map[string]any, len(fields))}
+	for name, value := range fields {
+		if name != "_additional" {
+			result.Properties[name] = value
+		}
+	}
+	additional, _ := fields["_additional"].(map[string]any)
+	if additional == nil {
+		return result
+	}
+	result.ID = toString(additional["id"])
+	result.Metadata = MetadataResult{
+		CreationTimeUnix:   toInt64(additional["creationTimeUnix"]),
+		LastUpdateTimeUnix: toInt64(additional["lastUpdateTimeUnix"]),
+		Certainty:          float32(toFloat64(additional["certainty"])),
+		Distance:           float32(toFloat64(additional["distance"])),
+		Score:              float32(toFloat64(additional["score"])),
+		ExplainScore:       toString(additional["explainScore"]),
+		IsConsistent:       additional["isConsistent"] == true,
+	}
+	if rerank, _ := additional["rerank"].([]any); len(rerank) > 0 {
+		if score, ok := rerank[0].(map[string]any); ok {
+			result.Metadata.RerankScore = toFloat64(score["score"])
+		}
+	}
+	result.Vector = toVector(additional["vector"])
+	if vectors, ok := additional["vectors"].(map[string]any); ok {
+		result.Vectors = make(map[string]Vector, len(vectors))
+		for name, v := range vectors {
+			if multi := toMultiVector(v); multi != nil {
+				result.Vectors[name] = Vector{Vector: multi}
+			} else {
+				result.Vectors[name] = Vector{Vector: toVector(v)}
+			}
+		}
+	}
+	if generate, ok := additional["generate"].(map[string]any); ok {
+		if single, ok := generate["singleResult"].(string); ok || generate["error"] != nil {
+			result.Generative = &GenerativeResult{Text: single, Error: toString(generate["error"])}
+		}
+	}
+	return result
+}
+
+// toGroupName returns the value of the grouped by property as the name of a group, like
+// the groups of a gRPC Search
+func toGroupName(value any) string {
+	if value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// toAggregationResult types the aggregations of a property by the fields queried
+func toAggregationResult(values map[string]any) (AggregationResult, error) {
+	var result AggregationResult
+	count, typ := toInt64(values["count"]), toString(values["type"])
+	_, hasTopOccurrences := values["topOccurrences"]
+	_, hasPointingTo := values["pointingTo"]
+	_, hasTotalTrue := values["totalTrue"]
+	_, hasPercentageTrue := values["percentageTrue"]
+	_, hasTotalFalse := values["totalFalse"]
+	_, hasPercentageFalse := values["percentageFalse"]
+	switch {
+	case hasTopOccurrences || typ == "text" || typ == "text[]":
+		result.Text = &TextAggregationResult{Count: count, Type: typ}
+		occurrences, _ := values["topOccurrences"].([]any)
+		for _, o := range occurrences {
+			occurrence, _ := o.(map[string]any)
+			result.Text.TopOccurrences = append(result.Text.TopOccurrences, TopOccurrence{
+				Value: toString(occurrence["value"]), Occurs: toInt64(occurrence["occurs"]),
+			})
+		}
+	case hasPointingTo:
+		result.Reference = &ReferenceAggregationResult{Type: typ, PointingTo: toStrings(values["pointingTo"])}
+	case hasTotalTrue || hasTotalFalse || hasPercentageTrue || hasPercentageFalse || typ == "boolean" || typ == "boolean[]":
+		result.Boolean = &BooleanAggregationResult{
+			Count: count, Type: typ,
+			TotalTrue: toInt64(values["totalTrue"]), TotalFalse: toInt64(values["totalFalse"]),
+			PercentageTrue: toFloat64(values["percentageTrue"]), PercentageFalse: toFloat64(values["percentageFalse"]),
+		}
+	case isDateAggregation(values, typ):
+		date := &DateAggregationResult{Count: count, Type: typ}
+		for _, v := range []struct {
+			name string
+			dst  *time.Time
+		}{{"median", &date.Median}, {"mode", &date.Mode}, {"maximum", &date.Maximum}, {"minimum", &date.Minimum}} {
+			value, _ := values[v.name].(string)
+			if value == "" {
+				continue
+			}
+			parsed, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return result, err
+			}
+			*v.dst = parsed
+		}
+		result.Date = date
+	default:
+		result.Number = &NumberAggregationResult{
+			Count: count, Type: typ,
+			Sum: toFloat64(values["sum"]), Mode: toFloat64(values["mode"]),
+			Mean: toFloat64(values["mean"]), Median: toFloat64(values["median"]),
+			Maximum: toFloat64(values["maximum"]), Minimum: toFloat64(values["minimum"]),
+		}
+	}
+	return result, nil
+}
+
+// isDateAggregation reports whether the aggregations are of a date property, whose
+// minimum, maximum, median and mode are returned as strings
+func isDateAggregation(values map[string]any, typ string) bool {
+	if typ == "date" || typ == "date[]" {
+		return true
+	}
+	for _, name := range []string{"median", "mode", "maximum", "minimum"} {
+		if _, ok := values[name].(string); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func toString(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+func toStrings(v any) []string {
+	items, _ := v.([]any)
+	if items == nil {
+		return nil
+	}
+	values := make([]string, len(items))
+	for i, item := range items {
+		values[i] = toString(item)
+	}
+	return values
+}
+
+// toFloat64 converts a number, which GraphQL returns as a string for scores and timestamps
+func toFloat64(v any) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case string:
+		f, _ := strconv.ParseFloat(n, 64)
+		return f
+	default:
+		return 0
+	}
+}
+
+func toInt64(v any) int64 {
+	switch n := v.(type) {
+	case float64:
+		return int64(n)
+	case string:
+		i, err := strconv.ParseInt(n, 10, 64)
+		if err != nil {
+			return int64(toFloat64(n))
+		}
+		return i
+	default:
+		return 0
+	}
+}
+
+func toVector(v any) []float32 {
+	items, _ := v.([]any)
+	if len(items) == 0 {
+		return nil
+	}
+	vector := make([]float32, len(items))
+	for i, item := range items {
+		f, ok := item.(float64)
+		if !ok {
+			return nil
+		}
+		vector[i] = float32(f)
+	}
+	return vector
+}
+
+func toMultiVector(v any) [][]float32 {
+	items, _ := v.([]any)
+	if len(items) == 0 {
+		return nil
+	}
+	if _, ok := items[0].([]any); !ok {
+		return nil
+	}
+	vectors := make([][]float32, len(items))
+	for i, item := range items {
+		vectors[i] = toVector(item)
+	}
+	return vectors
+}
@@ -0,0 +1,146 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+)
+
+func mockResponse(body string) *MockRunREST {
+	return &MockRunREST{ReturnResponseData: &connection.ResponseData{Body: []byte(body), StatusCode: 200}}
+}
+
+func TestResponse_Objects(t *testing.T) {
+	con := mockResponse(`{"data": {"Get": {"Article": [
+		{"title": "A", "_additional": {
+			"id": "00000000-0000-0000-0000-000000000001", "distance": 0.25, "score": "0.5", "explainScore": "bm25",
+			"creationTimeUnix": "1700000000000", "vector": [1, 2], "vectors": {"title": [3, 4], "colbert": [[5], [6]]},
+			"rerank": [{"score": 0.75}],
+			"generate": {"singleResult": "about A", "groupedResult": "all about A and B", "error": null}
+		}},
+		{"title": "B", "_additional": {"group": {
+			"id": 1, "count": 2, "minDistance": 0.1, "maxDistance": 0.2,
+			"groupedBy": {"value": "news", "path": ["category"]},
+			"hits": [{"title": "C", "_additional": {"id": "00000000-0000-0000-0000-000000000003", "distance": 0.1}}]
+		}}}
+	]}}}`)
+	resp, err := (&GetBuilder{connection: con}).WithClassName("Article").DoWithResult(context.Background())
+	require.NoError(t, err)
+
+	objects, err := resp.Objects("Article")
+	require.NoError(t, err)
+	require.Len(t, objects, 2)
+	a := objects[0]
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", a.ID)
+	assert.Equal(t, "Article", a.Collection)
+	assert.Equal(t, map[string]any{"title": "A"}, a.Properties)
+	assert.Equal(t, MetadataResult{
+		CreationTimeUnix: 1700000000000,
+		Distance:         0.25,
+		Score:            0.5,
+		ExplainScore:     "bm25",
+		RerankScore:      0.75,
+	}, a.Metadata)
+	assert.Equal(t, []float32{1, 2}, a.Vector)
+	assert.Equal(t, []float32{3, 4}, a.Vectors["title"].GetVector())
+	assert.Equal(t, [][]float32{{5}, {6}}, a.Vectors["colbert"].GetMultiVector())
+	assert.Equal(t, &GenerativeResult{Text: "about A"}, a.Generative)
+	assert.Nil(t, objects[1].Generative)
+
+	generative, err := resp.Generative("Article")
+	require.NoError(t, err)
+	assert.Equal(t, &GenerativeResult{Text: "all about A and B"}, generative)
+
+	groups, err := resp.Groups("Article")
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	group := groups[0]
+	assert.Equal(t, "news", group.Name)
+	assert.Equal(t, int64(2), group.NumberOfObjects)
+	assert.Equal(t, float32(0.2), group.MaxDistance)
+	require.Len(t, group.Objects, 1)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000003", group.Objects[0].ID)
+	assert.Equal(t, float32(0.1), group.Objects[0].Metadata.Distance)
+
+	objects, err = resp.Objects("Author")
+	require.NoError(t, err)
+	assert.Empty(t, objects)
+}
+
+func TestResponse_Errors(t *testing.T) {
+	con := mockResponse(`{"data": {"Get": {"Article": [{"title": "A"}]}},
+		"errors": [{"message": "vectorizer not found", "path": ["Get", "Article"]}, {"message": "timeout"}]}`)
+	resp, err := (&GetBuilder{connection: con}).WithClassName("Article").DoWithResult(context.Background())
+	require.Error(t, err)
+	assert.EqualError(t, err, "graphql: vectorizer not found; timeout")
+	var responseErrors ResponseErrors
+	require.True(t, errors.As(err, &responseErrors))
+	assert.Equal(t, []string{"Get", "Article"}, responseErrors[0].Path)
+
+	// the partial results are returned with the errors
+	objects, err := resp.Objects("Article")
+	require.NoError(t, err)
+	assert.Len(t, objects, 1)
+
+	t.Run("Do returns the errors along with the response", func(t *testing.T) {
+		resp, err := (&GetBuilder{connection: con}).WithClassName("Article").Do(context.Background())
+		assert.ErrorAs(t, err, &responseErrors)
+		require.NotNil(t, resp)
+		assert.Len(t, resp.Errors, 2)
+	})
+
+	t.Run("request failure", func(t *testing.T) {
+		con := &MockRunREST{ReturnError: errors.New("connection refused")}
+		resp, err := (&GetBuilder{connection: con}).WithClassName("Article").DoWithResult(context.Background())
+		assert.Error(t, err)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestResponse_Aggregations(t *testing.T) {
+	con := mockResponse(`{"data": {"Aggregate": {"Article": [{
+		"meta": {"count": 4},
+		"groupedBy": {"value": "news", "path": ["category"]},
+		"wordCount": {"count": 4, "mean": 2.5, "maximum": 4},
+		"title": {"count": 4, "topOccurrences": [{"value": "A", "occurs": 2}]},
+		"published": {"percentageTrue": 0.75, "totalTrue": 3},
+		"date": {"minimum": "2024-01-01T00:00:00Z"},
+		"hasAuthors": {"pointingTo": ["Author"]}
+	}]}}}`)
+	resp, err := (&AggregateBuilder{connection: con}).WithClassName("Article").DoWithResult(context.Background())
+	require.NoError(t, err)
+
+	groups, err := resp.Aggregations("Article")
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	g := groups[0]
+	assert.Equal(t, int64(4), g.ObjectsCount)
+	assert.Equal(t, GroupedBy{Path: []string{"category"}, Value: "news"}, g.GroupedBy)
+	assert.Equal(t, &NumberAggregationResult{Count: 4, Mean: 2.5, Maximum: 4}, g.Properties["wordCount"].Number)
+	assert.Equal(t, []TopOccurrence{{Value: "A", Occurs: 2}}, g.Properties["title"].Text.TopOccurrences)
+	assert.Equal(t, &BooleanAggregationResult{TotalTrue: 3, PercentageTrue: 0.75}, g.Properties["published"].Boolean)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), g.Properties["date"].Date.Minimum)
+	assert.Equal(t, []string{"Author"}, g.Properties["hasAuthors"].Reference.PointingTo)
+}
+
+func TestResponse_Explore(t *testing.T) {
+	con := mockResponse(`{"data": {"Explore": [
+		{"beacon": "weaviate://localhost/Article/00000000-0000-0000-0000-000000000001", "className": "Article", "certainty": 0.9, "distance": 0.2}
+	]}}`)
+	resp, err := (&Explore{connection: con}).DoWithResult(context.Background())
+	require.NoError(t, err)
+
+	results, err := resp.Explore()
+	require.NoError(t, err)
+	assert.Equal(t, []ExploreResult{{
+		Beacon:    "weaviate://localhost/Article/00000000-0000-0000-0000-000000000001",
+		ClassName: "Article",
+		Certainty: 0.9,
+		Distance:  0.2,
+	}}, results)
+}
@@ -3,13 +3,18 @@ package graphql
 import (
 	"context"
 	"fmt"
+	"iter"
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/grpc/common"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 )
 
+// defaultPageSize is the number of results Search.All fetches in one request
+const defaultPageSize = 100
+
 type Search struct {
 	grpcClient *connection.GrpcClient
 
@@ -35,10 +40,14 @@ type Search struct {
 	withBM25        *BM25ArgumentBuilder
 	withWhere       *filters.WhereBuilder
 	withSortBy      *SortBuilder
+	withGroupBy     *GroupByArgumentBuilder
+	withRerank      *pb.Rerank
 
 	withProperties []string
 	withReferences []*Reference
+	withObjects    []*ObjectProperty
 	withMetadata   *Metadata
+	withGenerative *GenerativeSearchBuilder
 }
 
 func NewSearch(grpcClient *connection.GrpcClient) *Search {
@@ -147,6 +156,24 @@ func (s *Search) WithSort(sort ...Sort) *Search {
 	return s
 }
 
+// WithGroupBy groups the results by a property. The groups are returned with
+// SearchReply.Groups, SearchReply.Results is empty.
+func (s *Search) WithGroupBy(groupBy *GroupByArgumentBuilder) *Search {
+	s.withGroupBy = groupBy
+	return s
+}
+
+// WithRerank reranks the results with the reranker module of the collection, by the
+// relevance of a property to a query, or to the query of the search if query is empty.
+// The scores are returned with MetadataResult.RerankScore.
+func (s *Search) WithRerank(property, query string) *Search {
+	s.withRerank = &pb.Rerank{Property: property}
+	if query != "" {
+		s.withRerank.Query = &query
+	}
+	return s
+}
+
 func (s *Search) WithProperties(properties ...string) *Search {
 	s.withProperties = properties
 	return s
@@ -157,11 +184,31 @@ func (s *Search) WithReferences(references ...*Reference) *Search {
 	return s
 }
 
+// WithObject selects properties of a nested object property
+func (s *Search) WithObject(name string, properties ...string) *Search {
+	s.withObjects = append(s.withObjects, &ObjectProperty{Name: name, Properties: properties})
+	return s
+}
+
+// WithObjects selects properties of nested object properties, which can hold further objects
+func (s *Search) WithObjects(objects ...*ObjectProperty) *Search {
+	s.withObjects = append(s.withObjects, objects...)
+	return s
+}
+
 func (s *Search) WithMetadata(metadata *Metadata) *Search {
 	s.withMetadata = metadata
 	return s
 }
 
+// WithGenerative generates text for each result with a single prompt, and for all
+// results with a grouped task. The text is returned with SearchResult.Generative
+// and SearchReply.Generative.
+func (s *Search) WithGenerative(generative *GenerativeSearchBuilder) *Search {
+	s.withGenerative = generative
+	return s
+}
+
 func (s *Search) togrpc() *pb.SearchRequest {
 	req := &pb.SearchRequest{
 		Collection:       s.collection,
@@ -211,6 +258,10 @@ func (s *Search) togrpc() *pb.SearchRequest {
 	if s.withSortBy != nil {
 		req.SortBy = s.withSortBy.togrpc()
 	}
+	if s.withGroupBy != nil {
+		req.GroupBy = s.withGroupBy.togrpc()
+	}
+	req.Rerank = s.withRerank
 	withProps := &Properties{}
 	if len(s.withProperties) > 0 {
 		withProps.WithProperties(s.withProperties...)
@@ -218,6 +269,9 @@ func (s *Search) togrpc() *pb.SearchRequest {
 	if len(s.withReferences) > 0 {
 		withProps.WithReferences(s.withReferences...)
 	}
+	if len(s.withObjects) > 0 {
+		withProps.WithObjects(s.withObjects...)
+	}
 	req.Properties = withProps.togrpc()
 	if s.withMetadata != nil {
 		req.Metadata = s.withMetadata.togrpc()
@@ -225,6 +279,9 @@ func (s *Search) togrpc() *pb.SearchRequest {
 		// by default always return ID
 		req.Metadata = &pb.MetadataRequest{Uuid: true}
 	}
+	if s.withGenerative != nil {
+		req.Generative = s.withGenerative.togrpc()
+	}
 	req.Uses_123Api = true
 	req.Uses_125Api = true
 	req.Uses_127Api = true
@@ -232,12 +289,66 @@ func (s *Search) togrpc() *pb.SearchRequest {
 }
 
 func (s *Search) Do(ctx context.Context) ([]SearchResult, error) {
+	reply, err := s.DoWithReply(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return reply.Results, nil
+}
+
+// DoWithReply runs the search and returns the results along with what the server
+// returns for all of them, such as the text generated for a grouped task.
+func (s *Search) DoWithReply(ctx context.Context) (*SearchReply, error) {
+	ctx, op := s.grpcClient.Telemetry().Start(ctx, "graphql.Search",
+		telemetry.Collection(s.collection), telemetry.Tenant(s.tenant), telemetry.ConsistencyLevel(s.consistencyLevel))
+	reply, err := s.do(ctx)
+	op.End(err)
+	return reply, err
+}
+
+// All returns an iterator over all results, which fetches the pages lazily with
+// the after cursor. The limit set with WithLimit is the page size, 100 by default.
+// As the after cursor, it cannot be combined with search operators, filters or sorting.
+// Iterating stops at the first error.
+func (s *Search) All(ctx context.Context) iter.Seq2[SearchResult, error] {
+	return func(yield func(SearchResult, error) bool) {
+		// page on a copy, so that the builder can be iterated again
+		page := *s
+		if page.limit == 0 {
+			page.limit = defaultPageSize
+		}
+		if page.withMetadata != nil && !page.withMetadata.ID {
+			// the ID of the last result is the cursor of the next page
+			metadata := *page.withMetadata
+			metadata.ID = true
+			page.withMetadata = &metadata
+		}
+		for {
+			results, err := page.Do(ctx)
+			if err != nil {
+				yield(SearchResult{}, err)
+				return
+			}
+			for _, result := range results {
+				if !yield(result, nil) {
+					return
+				}
+			}
+			if len(results) < int(page.limit) || len(results) == 0 {
+				return
+			}
+			page.after = results[len(results)-1].ID
+		}
+	}
+}
+
+func (s *Search) do(ctx context.Context) (*SearchReply, error) {
 	if s.grpcClient != nil {
 		reply, err := s.grpcClient.Search(ctx, s.togrpc())
 		if err != nil {
 			return nil, err
 		}
-		return toResults(reply.Results), nil
+		return toReply(reply), nil
 	}
 	return nil, fmt.Errorf("please provide gRPC config to the client in order to use search functionality")
 }
@@ -0,0 +1,325 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+// Aggregate aggregates the properties of a collection over gRPC. The objects can be
+// narrowed down with a filter and one search operator.
+type Aggregate struct {
+	grpcClient *connection.GrpcClient
+
+	collection string
+	tenant     string
+
+	objectsCount    bool
+	aggregations    []PropertyAggregation
+	objectLimit     *uint32
+	limit           *uint32
+	groupBy         string
+	withWhere       *filters.WhereBuilder
+	withNearText    *NearTextArgumentBuilder
+	withNearVector  *NearVectorArgumentBuilder
+	withNearObject  *NearObjectArgumentBuilder
+	withNearImage   *NearImageArgumentBuilder
+	withNearAudio   *NearAudioArgumentBuilder
+	withNearVideo   *NearVideoArgumentBuilder
+	withNearDepth   *NearDepthArgumentBuilder
+	withNearThermal *NearThermalArgumentBuilder
+	withNearImu     *NearImuArgumentBuilder
+	withHybrid      *HybridArgumentBuilder
+}
+
+func NewAggregate(grpcClient *connection.GrpcClient) *Aggregate {
+	return &Aggregate{grpcClient: grpcClient}
+}
+
+func (a *Aggregate) WithCollection(collection string) *Aggregate {
+	a.collection = collection
+	return a
+}
+
+func (a *Aggregate) WithTenant(tenant string) *Aggregate {
+	a.tenant = tenant
+	return a
+}
+
+// WithObjectsCount returns the number of aggregated objects
+func (a *Aggregate) WithObjectsCount() *Aggregate {
+	a.objectsCount = true
+	return a
+}
+
+// WithAggregations of properties, e.g. &IntAggregation{Property: "wordCount", Mean: true}
+func (a *Aggregate) WithAggregations(aggregations ...PropertyAggregation) *Aggregate {
+	a.aggregations = aggregations
+	return a
+}
+
+// WithObjectLimit limits the number of objects found by a search operator which are aggregated
+func (a *Aggregate) WithObjectLimit(objectLimit int) *Aggregate {
+	limit := uint32(objectLimit)
+	a.objectLimit = &limit
+	return a
+}
+
+// WithLimit limits the number of groups
+func (a *Aggregate) WithLimit(limit int) *Aggregate {
+	l := uint32(limit)
+	a.limit = &l
+	return a
+}
+
+// WithGroupBy aggregates the objects grouped by the values of a property
+func (a *Aggregate) WithGroupBy(property string) *Aggregate {
+	a.groupBy = property
+	return a
+}
+
+func (a *Aggregate) WithWhere(where *filters.WhereBuilder) *Aggregate {
+	a.withWhere = where
+	return a
+}
+
+func (a *Aggregate) WithNearText(nearText *NearTextArgumentBuilder) *Aggregate {
+	a.withNearText = nearText
+	return a
+}
+
+func (a *Aggregate) WithNearVector(nearVector *NearVectorArgumentBuilder) *Aggregate {
+	a.withNearVector = nearVector
+	return a
+}
+
+func (a *Aggregate) WithNearObject(nearObject *NearObjectArgumentBuilder) *Aggregate {
+	a.withNearObject = nearObject
+	return a
+}
+
+func (a *Aggregate) WithNearImage(nearImage *NearImageArgumentBuilder) *Aggregate {
+	a.withNearImage = nearImage
+	return a
+}
+
+func (a *Aggregate) WithNearAudio(nearAudio *NearAudioArgumentBuilder) *Aggregate {
+	a.withNearAudio = nearAudio
+	return a
+}
+
+func (a *Aggregate) WithNearVideo(nearVideo *NearVideoArgumentBuilder) *Aggregate {
+	a.withNearVideo = nearVideo
+	return a
+}
+
+func (a *Aggregate) WithNearDepth(nearDepth *NearDepthArgumentBuilder) *Aggregate {
+	a.withNearDepth = nearDepth
+	return a
+}
+
+func (a *Aggregate) WithNearThermal(nearThermal *NearThermalArgumentBuilder) *Aggregate {
+	a.withNearThermal = nearThermal
+	return a
+}
+
+func (a *Aggregate) WithNearImu(nearImu *NearImuArgumentBuilder) *Aggregate {
+	a.withNearImu = nearImu
+	return a
+}
+
+func (a *Aggregate) WithHybrid(hybrid *HybridArgumentBuilder) *Aggregate {
+	a.withHybrid = hybrid
+	return a
+}
+
+func (a *Aggregate) togrpc() *pb.AggregateRequest {
+	req := &pb.AggregateRequest{
+		Collection:   a.collection,
+		Tenant:       a.tenant,
+		ObjectsCount: a.objectsCount,
+		ObjectLimit:  a.objectLimit,
+		Limit:        a.limit,
+	}
+	for _, aggregation := range a.aggregations {
+		req.Aggregations = append(req.Aggregations, aggregation.togrpc())
+	}
+	if a.groupBy != "" {
+		req.GroupBy = &pb.AggregateRequest_GroupBy{Collection: a.collection, Property: a.groupBy}
+	}
+	if a.withWhere != nil {
+		req.Filters = a.withWhere.ToGRPC()
+	}
+	switch {
+	case a.withNearText != nil:
+		req.Search = &pb.AggregateRequest_NearText{NearText: a.withNearText.togrpc()}
+	case a.withNearVector != nil:
+		req.Search = &pb.AggregateRequest_NearVector{NearVector: a.withNearVector.togrpc()}
+	case a.withNearObject != nil:
+		req.Search = &pb.AggregateRequest_NearObject{NearObject: a.withNearObject.togrpc()}
+	case a.withNearImage != nil:
+		req.Search = &pb.AggregateRequest_NearImage{NearImage: a.withNearImage.togrpc()}
+	case a.withNearAudio != nil:
+		req.Search = &pb.AggregateRequest_NearAudio{NearAudio: a.withNearAudio.togrpc()}
+	case a.withNearVideo != nil:
+		req.Search = &pb.AggregateRequest_NearVideo{NearVideo: a.withNearVideo.togrpc()}
+	case a.withNearDepth != nil:
+		req.Search = &pb.AggregateRequest_NearDepth{NearDepth: a.withNearDepth.togrpc()}
+	case a.withNearThermal != nil:
+		req.Search = &pb.AggregateRequest_NearThermal{NearThermal: a.withNearThermal.togrpc()}
+	case a.withNearImu != nil:
+		req.Search = &pb.AggregateRequest_NearImu{NearImu: a.withNearImu.togrpc()}
+	case a.withHybrid != nil:
+		req.Search = &pb.AggregateRequest_Hybrid{Hybrid: a.withHybrid.togrpc()}
+	}
+	return req
+}
+
+func (a *Aggregate) Do(ctx context.Context) (*AggregateResult, error) {
+	ctx, op := a.grpcClient.Telemetry().Start(ctx, "graphql.Aggregate",
+		telemetry.Collection(a.collection), telemetry.Tenant(a.tenant))
+	result, err := a.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (a *Aggregate) do(ctx context.Context) (*AggregateResult, error) {
+	if a.grpcClient != nil {
+		reply, err := a.grpcClient.Aggregate(ctx, a.togrpc())
+		if err != nil {
+			return nil, err
+		}
+		return toAggregateResult(reply)
+	}
+	return nil, fmt.Errorf("please provide gRPC config to the client in order to use aggregate functionality")
+}
+
+// PropertyAggregation selects the aggregations of a property. It is one of
+// IntAggregation, NumberAggregation, TextAggregation, BooleanAggregation,
+// DateAggregation and ReferenceAggregation.
+type PropertyAggregation interface {
+	togrpc() *pb.AggregateRequest_Aggregation
+}
+
+type IntAggregation struct {
+	Property string
+	Count    bool
+	Type     bool
+	Sum      bool
+	Mean     bool
+	Mode     bool
+	Median   bool
+	Maximum  bool
+	Minimum  bool
+}
+
+func (a *IntAggregation) togrpc() *pb.AggregateRequest_Aggregation {
+	return &pb.AggregateRequest_Aggregation{
+		Property: a.Property,
+		Aggregation: &pb.AggregateRequest_Aggregation_Int{Int: &pb.AggregateRequest_Aggregation_Integer{
+			Count: a.Count, Type: a.Type, Sum: a.Sum, Mean: a.Mean,
+			Mode: a.Mode, Median: a.Median, Maximum: a.Maximum, Minimum: a.Minimum,
+		}},
+	}
+}
+
+type NumberAggregation struct {
+	Property string
+	Count    bool
+	Type     bool
+	Sum      bool
+	Mean     bool
+	Mode     bool
+	Median   bool
+	Maximum  bool
+	Minimum  bool
+}
+
+func (a *NumberAggregation) togrpc() *pb.AggregateRequest_Aggregation {
+	return &pb.AggregateRequest_Aggregation{
+		Property: a.Property,
+		Aggregation: &pb.AggregateRequest_Aggregation_Number_{Number: &pb.AggregateRequest_Aggregation_Number{
+			Count: a.Count, Type: a.Type, Sum: a.Sum, Mean: a.Mean,
+			Mode: a.Mode, Median: a.Median, Maximum: a.Maximum, Minimum: a.Minimum,
+		}},
+	}
+}
+
+type TextAggregation struct {
+	Property       string
+	Count          bool
+	Type           bool
+	TopOccurrences bool
+	// TopOccurrencesLimit is the number of top occurrences returned, the server default if 0
+	TopOccurrencesLimit int
+}
+
+func (a *TextAggregation) togrpc() *pb.AggregateRequest_Aggregation {
+	text := &pb.AggregateRequest_Aggregation_Text{Count: a.Count, Type: a.Type, TopOccurences: a.TopOccurrences}
+	if a.TopOccurrencesLimit > 0 {
+		limit := uint32(a.TopOccurrencesLimit)
+		text.TopOccurencesLimit = &limit
+	}
+	return &pb.AggregateRequest_Aggregation{
+		Property:    a.Property,
+		Aggregation: &pb.AggregateRequest_Aggregation_Text_{Text: text},
+	}
+}
+
+type BooleanAggregation struct {
+	Property        string
+	Count           bool
+	Type            bool
+	TotalTrue       bool
+	TotalFalse      bool
+	PercentageTrue  bool
+	PercentageFalse bool
+}
+
+func (a *BooleanAggregation) togrpc() *pb.AggregateRequest_Aggregation {
+	return &pb.AggregateRequest_Aggregation{
+		Property: a.Property,
+		Aggregation: &pb.AggregateRequest_Aggregation_Boolean_{Boolean: &pb.AggregateRequest_Aggregation_Boolean{
+			Count: a.Count, Type: a.Type, TotalTrue: a.TotalTrue, TotalFalse: a.TotalFalse,
+			PercentageTrue: a.PercentageTrue, PercentageFalse: a.PercentageFalse,
+		}},
+	}
+}
+
+type DateAggregation struct {
+	Property string
+	Count    bool
+	Type     bool
+	Median   bool
+	Mode     bool
+	Maximum  bool
+	Minimum  bool
+}
+
+func (a *DateAggregation) togrpc() *pb.AggregateRequest_Aggregation {
+	return &pb.AggregateRequest_Aggregation{
+		Property: a.Property,
+		Aggregation: &pb.AggregateRequest_Aggregation_Date_{Date: &pb.AggregateRequest_Aggregation_Date{
+			Count: a.Count, Type: a.Type, Median: a.Median, Mode: a.Mode, Maximum: a.Maximum, Minimum: a.Minimum,
+		}},
+	}
+}
+
+type ReferenceAggregation struct {
+	Property   string
+	Type       bool
+	PointingTo bool
+}
+
+func (a *ReferenceAggregation) togrpc() *pb.AggregateRequest_Aggregation {
+	return &pb.AggregateRequest_Aggregation{
+		Property: a.Property,
+		Aggregation: &pb.AggregateRequest_Aggregation_Reference_{Reference: &pb.AggregateRequest_Aggregation_Reference{
+			Type: a.Type, PointingTo: a.PointingTo,
+		}},
+	}
+}
@@ -0,0 +1,195 @@
+package graphql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+// AggregateResult holds the aggregations of all objects, or of each group if grouped by a property
+type AggregateResult struct {
+	ObjectsCount int64
+	// Properties holds the aggregations by property name
+	Properties map[string]AggregationResult
+	Groups     []AggregateGroup
+}
+
+type AggregateGroup struct {
+	GroupedBy    GroupedBy
+	ObjectsCount int64
+	Properties   map[string]AggregationResult
+}
+
+// GroupedBy is the property and the value of the objects of a group. The value is
+// a string, int64, bool, float64, a slice of these or *models.GeoCoordinates.
+type GroupedBy struct {
+	Path  []string
+	Value any
+}
+
+// AggregationResult holds the aggregations of a property, with the field matching
+// the requested PropertyAggregation set
+type AggregationResult struct {
+	Int       *IntAggregationResult
+	Number    *NumberAggregationResult
+	Text      *TextAggregationResult
+	Boolean   *BooleanAggregationResult
+	Date      *DateAggregationResult
+	Reference *ReferenceAggregationResult
+}
+
+type IntAggregationResult struct {
+	Count            int64
+	Type             string
+	Sum, Mode        int64
+	Mean, Median     float64
+	Maximum, Minimum int64
+}
+
+type NumberAggregationResult struct {
+	Count            int64
+	Type             string
+	Sum, Mode        float64
+	Mean, Median     float64
+	Maximum, Minimum float64
+}
+
+type TextAggregationResult struct {
+	Count          int64
+	Type           string
+	TopOccurrences []TopOccurrence
+}
+
+type TopOccurrence struct {
+	Value  string
+	Occurs int64
+}
+
+type BooleanAggregationResult struct {
+	Count                           int64
+	Type                            string
+	TotalTrue, TotalFalse           int64
+	PercentageTrue, PercentageFalse float64
+}
+
+type DateAggregationResult struct {
+	Count            int64
+	Type             string
+	Median, Mode     time.Time
+	Maximum, Minimum time.Time
+}
+
+type ReferenceAggregationResult struct {
+	Type       string
+	PointingTo []string
+}
+
+func toAggregateResult(reply *pb.AggregateReply) (*AggregateResult, error) {
+	result := &AggregateResult{}
+	if single := reply.GetSingleResult(); single != nil {
+		properties, err := toAggregations(single.GetAggregations())
+		if err != nil {
+			return nil, err
+		}
+		result.ObjectsCount, result.Properties = single.GetObjectsCount(), properties
+	}
+	for _, g := range reply.GetGroupedResults().GetGroups() {
+		properties, err := toAggregations(g.GetAggregations())
+		if err != nil {
+			return nil, err
+		}
+		result.Groups = append(result.Groups, AggregateGroup{
+			GroupedBy:    toGroupedBy(g.GetGroupedBy()),
+			ObjectsCount: g.GetObjectsCount(),
+			Properties:   properties,
+		})
+	}
+	return result, nil
+}
+
+func toAggregations(a *pb.AggregateReply_Aggregations) (map[string]AggregationResult, error) {
+	if a == nil {
+		return nil, nil
+	}
+	aggregations := make(map[string]AggregationResult, len(a.GetAggregations()))
+	for _, aggregation := range a.GetAggregations() {
+		var result AggregationResult
+		switch {
+		case aggregation.GetInt() != nil:
+			i := aggregation.GetInt()
+			result.Int = &IntAggregationResult{
+				Count: i.GetCount(), Type: i.GetType(), Sum: i.GetSum(), Mode: i.GetMode(),
+				Mean: i.GetMean(), Median: i.GetMedian(), Maximum: i.GetMaximum(), Minimum: i.GetMinimum(),
+			}
+		case aggregation.GetNumber() != nil:
+			n := aggregation.GetNumber()
+			result.Number = &NumberAggregationResult{
+				Count: n.GetCount(), Type: n.GetType(), Sum: n.GetSum(), Mode: n.GetMode(),
+				Mean: n.GetMean(), Median: n.GetMedian(), Maximum: n.GetMaximum(), Minimum: n.GetMinimum(),
+			}
+		case aggregation.GetText() != nil:
+			t := aggregation.GetText()
+			result.Text = &TextAggregationResult{Count: t.GetCount(), Type: t.GetType()}
+			for _, item := range t.GetTopOccurences().GetItems() {
+				result.Text.TopOccurrences = append(result.Text.TopOccurrences,
+					TopOccurrence{Value: item.GetValue(), Occurs: item.GetOccurs()})
+			}
+		case aggregation.GetBoolean() != nil:
+			b := aggregation.GetBoolean()
+			result.Boolean = &BooleanAggregationResult{
+				Count: b.GetCount(), Type: b.GetType(), TotalTrue: b.GetTotalTrue(), TotalFalse: b.GetTotalFalse(),
+				PercentageTrue: b.GetPercentageTrue(), PercentageFalse: b.GetPercentageFalse(),
+			}
+		case aggregation.GetDate() != nil:
+			d := aggregation.GetDate()
+			date := &DateAggregationResult{Count: d.GetCount(), Type: d.GetType()}
+			for _, v := range []struct {
+				value *string
+				dst   *time.Time
+			}{{d.Median, &date.Median}, {d.Mode, &date.Mode}, {d.Maximum, &date.Maximum}, {d.Minimum, &date.Minimum}} {
+				if v.value == nil {
+					continue
+				}
+				parsed, err := time.Parse(time.RFC3339Nano, *v.value)
+				if err != nil {
+					return nil, fmt.Errorf("aggregation of %s: %w", aggregation.GetProperty(), err)
+				}
+				*v.dst = parsed
+			}
+			result.Date = date
+		case aggregation.GetReference() != nil:
+			r := aggregation.GetReference()
+			result.Reference = &ReferenceAggregationResult{Type: r.GetType(), PointingTo: r.GetPointingTo()}
+		}
+		aggregations[aggregation.GetProperty()] = result
+	}
+	return aggregations, nil
+}
+
+func toGroupedBy(g *pb.AggregateReply_Group_GroupedBy) GroupedBy {
+	groupedBy := GroupedBy{Path: g.GetPath()}
+	switch v := g.GetValue().(type) {
+	case *pb.AggregateReply_Group_GroupedBy_Text:
+		groupedBy.Value = v.Text
+	case *pb.AggregateReply_Group_GroupedBy_Int:
+		groupedBy.Value = v.Int
+	case *pb.AggregateReply_Group_GroupedBy_Boolean:
+		groupedBy.Value = v.Boolean
+	case *pb.AggregateReply_Group_GroupedBy_Number:
+		groupedBy.Value = v.Number
+	case *pb.AggregateReply_Group_GroupedBy_Texts:
+		groupedBy.Value = v.Texts.GetValues()
+	case *pb.AggregateReply_Group_GroupedBy_Ints:
+		groupedBy.Value = v.Ints.GetValues()
+	case *pb.AggregateReply_Group_GroupedBy_Booleans:
+		groupedBy.Value = v.Booleans.GetValues()
+	case *pb.AggregateReply_Group_GroupedBy_Numbers:
+		groupedBy.Value = v.Numbers.GetValues()
+	case *pb.AggregateReply_Group_GroupedBy_Geo:
+		latitude, longitude := v.Geo.GetLatitude(), v.Geo.GetLongitude()
+		groupedBy.Value = &models.GeoCoordinates{Latitude: &latitude, Longitude: &longitude}
+	}
+	return groupedBy
+}
@@ -0,0 +1,130 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/filters"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type aggregateServer struct {
+	pb.UnimplementedWeaviateServer
+	request *pb.AggregateRequest
+	reply   *pb.AggregateReply
+}
+
+func (a *aggregateServer) Aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	a.request = req
+	return a.reply, nil
+}
+
+func TestAggregate(t *testing.T) {
+	aggregations := &pb.AggregateReply_Aggregations{Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{
+		{Property: "wordCount", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Int{
+			Int: &pb.AggregateReply_Aggregations_Aggregation_Integer{Count: proto.Int64(3), Mean: proto.Float64(2.5), Maximum: proto.Int64(4)},
+		}},
+		{Property: "title", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Text_{
+			Text: &pb.AggregateReply_Aggregations_Aggregation_Text{
+				TopOccurences: &pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences{
+					Items: []*pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence{{Value: "news", Occurs: 2}},
+				},
+			},
+		}},
+		{Property: "published", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Boolean_{
+			Boolean: &pb.AggregateReply_Aggregations_Aggregation_Boolean{PercentageTrue: proto.Float64(0.75)},
+		}},
+		{Property: "date", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Date_{
+			Date: &pb.AggregateReply_Aggregations_Aggregation_Date{
+				Minimum: proto.String("2024-01-01T00:00:00Z"), Maximum: proto.String("2024-12-31T12:30:00.5Z"),
+			},
+		}},
+	}}
+
+	t.Run("single result", func(t *testing.T) {
+		server := &aggregateServer{reply: &pb.AggregateReply{Result: &pb.AggregateReply_SingleResult{
+			SingleResult: &pb.AggregateReply_Single{ObjectsCount: proto.Int64(4), Aggregations: aggregations},
+		}}}
+		where := filters.Where().WithPath([]string{"wordCount"}).WithOperator(filters.GreaterThan).WithValueInt(1)
+		result, err := NewAggregate(newTestGrpcClient(t, server)).WithCollection("Article").WithTenant("tenant").
+			WithObjectsCount().
+			WithAggregations(
+				&IntAggregation{Property: "wordCount", Count: true, Mean: true, Maximum: true},
+				&TextAggregation{Property: "title", TopOccurrences: true, TopOccurrencesLimit: 5},
+			).
+			WithWhere(where).
+			WithNearText((&NearTextArgumentBuilder{}).WithConcepts([]string{"news"})).
+			WithObjectLimit(10).
+			Do(context.Background())
+		require.NoError(t, err)
+
+		req := server.request
+		assert.Equal(t, "Article", req.Collection)
+		assert.Equal(t, "tenant", req.Tenant)
+		assert.True(t, req.ObjectsCount)
+		assert.Equal(t, uint32(10), req.GetObjectLimit())
+		assert.NotNil(t, req.Filters)
+		assert.Equal(t, []string{"news"}, req.GetNearText().GetQuery())
+		require.Len(t, req.Aggregations, 2)
+		assert.True(t, proto.Equal(&pb.AggregateRequest_Aggregation_Integer{Count: true, Mean: true, Maximum: true}, req.Aggregations[0].GetInt()))
+		assert.Equal(t, uint32(5), req.Aggregations[1].GetText().GetTopOccurencesLimit())
+
+		assert.Equal(t, int64(4), result.ObjectsCount)
+		assert.Equal(t, &IntAggregationResult{Count: 3, Mean: 2.5, Maximum: 4}, result.Properties["wordCount"].Int)
+		assert.Equal(t, []TopOccurrence{{Value: "news", Occurs: 2}}, result.Properties["title"].Text.TopOccurrences)
+		assert.Equal(t, 0.75, result.Properties["published"].Boolean.PercentageTrue)
+		date := result.Properties["date"].Date
+		assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), date.Minimum)
+		assert.Equal(t, time.Date(2024, 12, 31, 12, 30, 0, 5e8, time.UTC), date.Maximum)
+		assert.True(t, date.Median.IsZero())
+		assert.Empty(t, result.Groups)
+	})
+
+	t.Run("grouped results", func(t *testing.T) {
+		server := &aggregateServer{reply: &pb.AggregateReply{Result: &pb.AggregateReply_GroupedResults{
+			GroupedResults: &pb.AggregateReply_Grouped{Groups: []*pb.AggregateReply_Group{
+				{
+					ObjectsCount: proto.Int64(2),
+					GroupedBy:    &pb.AggregateReply_Group_GroupedBy{Path: []string{"category"}, Value: &pb.AggregateReply_Group_GroupedBy_Text{Text: "sports"}},
+					Aggregations: aggregations,
+				},
+				{
+					ObjectsCount: proto.Int64(1),
+					GroupedBy:    &pb.AggregateReply_Group_GroupedBy{Path: []string{"ratings"}, Value: &pb.AggregateReply_Group_GroupedBy_Ints{Ints: &pb.IntArray{Values: []int64{1, 2}}}},
+				},
+			}},
+		}}}
+		result, err := NewAggregate(newTestGrpcClient(t, server)).WithCollection("Article").
+			WithGroupBy("category").WithLimit(2).
+			Do(context.Background())
+		require.NoError(t, err)
+
+		assert.True(t, proto.Equal(&pb.AggregateRequest_GroupBy{Collection: "Article", Property: "category"}, server.request.GroupBy))
+		assert.Equal(t, uint32(2), server.request.GetLimit())
+		require.Len(t, result.Groups, 2)
+		assert.Equal(t, GroupedBy{Path: []string{"category"}, Value: "sports"}, result.Groups[0].GroupedBy)
+		assert.Equal(t, int64(2), result.Groups[0].ObjectsCount)
+		assert.Equal(t, int64(3), result.Groups[0].Properties["wordCount"].Int.Count)
+		assert.Equal(t, []int64{1, 2}, result.Groups[1].GroupedBy.Value)
+		assert.Nil(t, result.Groups[1].Properties)
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		server := &aggregateServer{reply: &pb.AggregateReply{Result: &pb.AggregateReply_SingleResult{
+			SingleResult: &pb.AggregateReply_Single{Aggregations: &pb.AggregateReply_Aggregations{
+				Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{{
+					Property: "date",
+					Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Date_{
+						Date: &pb.AggregateReply_Aggregations_Aggregation_Date{Mode: proto.String("yesterday")},
+					},
+				}},
+			}},
+		}}}
+		_, err := NewAggregate(newTestGrpcClient(t, server)).WithCollection("Article").Do(context.Background())
+		assert.ErrorContains(t, err, "aggregation of date")
+	})
+}
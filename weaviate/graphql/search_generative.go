@@ -0,0 +1,218 @@
+package graphql
+
+import (
+	"encoding/json"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Generative modules which can be set with NewGenerativeProvider
+const (
+	GenerativeAnthropic    = "anthropic"
+	GenerativeAnyscale     = "anyscale"
+	GenerativeAWS          = "aws"
+	GenerativeCohere       = "cohere"
+	GenerativeContextualAI = "contextualai"
+	GenerativeDatabricks   = "databricks"
+	GenerativeDummy        = "dummy"
+	GenerativeFriendliAI   = "friendliai"
+	GenerativeGoogle       = "google"
+	GenerativeMistral      = "mistral"
+	GenerativeNvidia       = "nvidia"
+	GenerativeOllama       = "ollama"
+	GenerativeOpenAI       = "openai"
+	GenerativeXAI          = "xai"
+)
+
+// GenerativeProviderBuilder sets the generative module of a search and overrides its
+// settings. Settings a module does not support are not sent.
+type GenerativeProviderBuilder struct {
+	name            string
+	model           *string
+	temperature     *float64
+	maxTokens       *int64
+	topP            *float64
+	baseURL         *string
+	images          []string
+	imageProperties []string
+	returnMetadata  bool
+}
+
+func NewGenerativeProvider(name string) *GenerativeProviderBuilder {
+	return &GenerativeProviderBuilder{name: name}
+}
+
+func (p *GenerativeProviderBuilder) WithModel(model string) *GenerativeProviderBuilder {
+	p.model = &model
+	return p
+}
+
+func (p *GenerativeProviderBuilder) WithTemperature(temperature float64) *GenerativeProviderBuilder {
+	p.temperature = &temperature
+	return p
+}
+
+func (p *GenerativeProviderBuilder) WithMaxTokens(maxTokens int64) *GenerativeProviderBuilder {
+	p.maxTokens = &maxTokens
+	return p
+}
+
+func (p *GenerativeProviderBuilder) WithTopP(topP float64) *GenerativeProviderBuilder {
+	p.topP = &topP
+	return p
+}
+
+// WithBaseURL sets the URL of the API of the module, its endpoint for aws,
+// databricks, google and ollama
+func (p *GenerativeProviderBuilder) WithBaseURL(baseURL string) *GenerativeProviderBuilder {
+	p.baseURL = &baseURL
+	return p
+}
+
+// WithImages passes base64 encoded images to the prompt
+func (p *GenerativeProviderBuilder) WithImages(images ...string) *GenerativeProviderBuilder {
+	p.images = images
+	return p
+}
+
+// WithImageProperties passes the images held by blob properties of the results to the prompt
+func (p *GenerativeProviderBuilder) WithImageProperties(properties ...string) *GenerativeProviderBuilder {
+	p.imageProperties = properties
+	return p
+}
+
+// WithMetadata returns the metadata of the module, e.g. the used tokens, with the results
+func (p *GenerativeProviderBuilder) WithMetadata() *GenerativeProviderBuilder {
+	p.returnMetadata = true
+	return p
+}
+
+func (p *GenerativeProviderBuilder) togrpc() *pb.GenerativeProvider {
+	provider := &pb.GenerativeProvider{ReturnMetadata: p.returnMetadata}
+	images, imageProperties := textArray(p.images), textArray(p.imageProperties)
+	switch p.name {
+	case GenerativeAnthropic:
+		provider.Kind = &pb.GenerativeProvider_Anthropic{Anthropic: &pb.GenerativeAnthropic{
+			BaseUrl: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, TopP: p.topP,
+			Images: images, ImageProperties: imageProperties,
+		}}
+	case GenerativeAnyscale:
+		provider.Kind = &pb.GenerativeProvider_Anyscale{Anyscale: &pb.GenerativeAnyscale{
+			BaseUrl: p.baseURL, Model: p.model, Temperature: p.temperature,
+		}}
+	case GenerativeAWS:
+		provider.Kind = &pb.GenerativeProvider_Aws{Aws: &pb.GenerativeAWS{
+			Endpoint: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens,
+			Images: images, ImageProperties: imageProperties,
+		}}
+	case GenerativeCohere:
+		provider.Kind = &pb.GenerativeProvider_Cohere{Cohere: &pb.GenerativeCohere{
+			BaseUrl: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, P: p.topP,
+			Images: images, ImageProperties: imageProperties,
+		}}
+	case GenerativeContextualAI:
+		provider.Kind = &pb.GenerativeProvider_Contextualai{Contextualai: &pb.GenerativeContextualAI{
+			Model: p.model, Temperature: p.temperature, MaxNewTokens: p.maxTokens, TopP: p.topP,
+		}}
+	case GenerativeDatabricks:
+		provider.Kind = &pb.GenerativeProvider_Databricks{Databricks: &pb.GenerativeDatabricks{
+			Endpoint: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, TopP: p.topP,
+		}}
+	case GenerativeDummy:
+		provider.Kind = &pb.GenerativeProvider_Dummy{Dummy: &pb.GenerativeDummy{}}
+	case GenerativeFriendliAI:
+		provider.Kind = &pb.GenerativeProvider_Friendliai{Friendliai: &pb.GenerativeFriendliAI{
+			BaseUrl: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, TopP: p.topP,
+		}}
+	case GenerativeGoogle:
+		provider.Kind = &pb.GenerativeProvider_Google{Google: &pb.GenerativeGoogle{
+			ApiEndpoint: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, TopP: p.topP,
+			Images: images, ImageProperties: imageProperties,
+		}}
+	case GenerativeMistral:
+		provider.Kind = &pb.GenerativeProvider_Mistral{Mistral: &pb.GenerativeMistral{
+			BaseUrl: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, TopP: p.topP,
+		}}
+	case GenerativeNvidia:
+		provider.Kind = &pb.GenerativeProvider_Nvidia{Nvidia: &pb.GenerativeNvidia{
+			BaseUrl: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, TopP: p.topP,
+		}}
+	case GenerativeOllama:
+		provider.Kind = &pb.GenerativeProvider_Ollama{Ollama: &pb.GenerativeOllama{
+			ApiEndpoint: p.baseURL, Model: p.model, Temperature: p.temperature,
+			Images: images, ImageProperties: imageProperties,
+		}}
+	case GenerativeOpenAI:
+		provider.Kind = &pb.GenerativeProvider_Openai{Openai: &pb.GenerativeOpenAI{
+			BaseUrl: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, TopP: p.topP,
+			Images: images, ImageProperties: imageProperties,
+		}}
+	case GenerativeXAI:
+		provider.Kind = &pb.GenerativeProvider_Xai{Xai: &pb.GenerativeXAI{
+			BaseUrl: p.baseURL, Model: p.model, Temperature: p.temperature, MaxTokens: p.maxTokens, TopP: p.topP,
+			Images: images, ImageProperties: imageProperties,
+		}}
+	}
+	return provider
+}
+
+func textArray(values []string) *pb.TextArray {
+	if len(values) == 0 {
+		return nil
+	}
+	return &pb.TextArray{Values: values}
+}
+
+// GenerativeResult is the text generated for a search result, or for all results
+// with a grouped task
+type GenerativeResult struct {
+	Text string
+	// Prompt is the full prompt sent to the generative module, set with debug enabled
+	Prompt string
+	// Metadata returned by the generative module, keyed by its name, e.g.
+	// {"openai": {"usage": {"promptTokens": 12, ...}}}
+	Metadata map[string]any
+	// Error of the generative module, only returned by GraphQL queries
+	Error string
+}
+
+func extractGenerative(r *pb.GenerativeResult) *GenerativeResult {
+	values := r.GetValues()
+	if len(values) == 0 {
+		return nil
+	}
+	reply := values[0]
+	result := &GenerativeResult{
+		Text:   reply.GetResult(),
+		Prompt: reply.GetDebug().GetFullPrompt(),
+	}
+	if reply.GetMetadata() != nil {
+		if data, err := protojson.Marshal(reply.GetMetadata()); err == nil {
+			json.Unmarshal(data, &result.Metadata)
+		}
+	}
+	return result
+}
+
+// extractSingleGenerative returns the text generated for a result, which servers
+// before v1.27 return with its metadata
+func extractSingleGenerative(r *pb.SearchResult) *GenerativeResult {
+	if generative := extractGenerative(r.GetGenerative()); generative != nil {
+		return generative
+	}
+	if m := r.GetMetadata(); m.GetGenerativePresent() {
+		return &GenerativeResult{Text: m.GetGenerative()}
+	}
+	return nil
+}
+
+func extractGroupedGenerative(r *pb.SearchReply) *GenerativeResult {
+	if generative := extractGenerative(r.GetGenerativeGroupedResults()); generative != nil {
+		return generative
+	}
+	if r.GenerativeGroupedResult != nil {
+		return &GenerativeResult{Text: r.GetGenerativeGroupedResult()}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type generativeSearchServer struct {
+	pb.UnimplementedWeaviateServer
+	request *pb.SearchRequest
+}
+
+func (g *generativeSearchServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	g.request = req
+	promptTokens := int64(12)
+	return &pb.SearchReply{
+		Results: []*pb.SearchResult{
+			{
+				Metadata: &pb.MetadataResult{Id: "1"},
+				Generative: &pb.GenerativeResult{Values: []*pb.GenerativeReply{{
+					Result: "a pizza",
+					Debug:  &pb.GenerativeDebug{FullPrompt: proto.String("Describe Margherita")},
+					Metadata: &pb.GenerativeMetadata{Kind: &pb.GenerativeMetadata_Openai{Openai: &pb.GenerativeOpenAIMetadata{
+						Usage: &pb.GenerativeOpenAIMetadata_Usage{PromptTokens: &promptTokens},
+					}}},
+				}}},
+			},
+			// servers before v1.27 return the text with the metadata
+			{Metadata: &pb.MetadataResult{Id: "2", Generative: "another pizza", GenerativePresent: true}},
+			{Metadata: &pb.MetadataResult{Id: "3"}},
+		},
+		GenerativeGroupedResults: &pb.GenerativeResult{Values: []*pb.GenerativeReply{{Result: "popular pizzas"}}},
+	}, nil
+}
+
+func TestSearch_WithGenerative(t *testing.T) {
+	server := &generativeSearchServer{}
+	generative := NewGenerativeSearch().
+		SingleResult("Describe {name}").
+		GroupedResult("Why are these pizzas popular?", "name", "description").
+		WithDebug().
+		WithProvider(NewGenerativeProvider(GenerativeOpenAI).
+			WithModel("gpt-4o").WithTemperature(0).WithMaxTokens(100).
+			WithImages("aW1hZ2U=").WithImageProperties("photo").WithMetadata())
+	reply, err := NewSearch(newTestGrpcClient(t, server)).WithCollection("Pizza").WithGenerative(generative).
+		DoWithReply(context.Background())
+	require.NoError(t, err)
+
+	provider := &pb.GenerativeProvider{
+		ReturnMetadata: true,
+		Kind: &pb.GenerativeProvider_Openai{Openai: &pb.GenerativeOpenAI{
+			Model:           proto.String("gpt-4o"),
+			Temperature:     proto.Float64(0),
+			MaxTokens:       proto.Int64(100),
+			Images:          &pb.TextArray{Values: []string{"aW1hZ2U="}},
+			ImageProperties: &pb.TextArray{Values: []string{"photo"}},
+		}},
+	}
+	assert.True(t, proto.Equal(&pb.GenerativeSearch{
+		Single: &pb.GenerativeSearch_Single{Prompt: "Describe {name}", Debug: true, Queries: []*pb.GenerativeProvider{provider}},
+		Grouped: &pb.GenerativeSearch_Grouped{
+			Task:       "Why are these pizzas popular?",
+			Properties: &pb.TextArray{Values: []string{"name", "description"}},
+			Debug:      true,
+			Queries:    []*pb.GenerativeProvider{provider},
+		},
+	}, server.request.Generative), "%v", server.request.Generative)
+
+	require.Len(t, reply.Results, 3)
+	assert.Equal(t, &GenerativeResult{
+		Text:     "a pizza",
+		Prompt:   "Describe Margherita",
+		Metadata: map[string]any{"openai": map[string]any{"usage": map[string]any{"promptTokens": "12"}}},
+	}, reply.Results[0].Generative)
+	assert.Equal(t, &GenerativeResult{Text: "another pizza"}, reply.Results[1].Generative)
+	assert.Nil(t, reply.Results[2].Generative)
+	assert.Equal(t, &GenerativeResult{Text: "popular pizzas"}, reply.Generative)
+}
+
+func TestSearch_WithGenerative_BeforeV127(t *testing.T) {
+	server := &legacyGenerativeSearchServer{}
+	generative := NewGenerativeSearch().
+		SingleResult("Describe {name}").
+		GroupedResult("Why are these pizzas popular?", "name")
+	reply, err := NewSearch(newTestGrpcClient(t, server)).WithCollection("Pizza").WithGenerative(generative).
+		DoWithReply(context.Background())
+	require.NoError(t, err)
+
+	req := server.request.Generative
+	assert.Equal(t, "Describe {name}", req.SingleResponsePrompt)
+	assert.Equal(t, "Why are these pizzas popular?", req.GroupedResponseTask)
+	assert.Equal(t, []string{"name"}, req.GroupedProperties)
+	assert.Equal(t, "Describe {name}", req.Single.GetPrompt())
+
+	require.Len(t, reply.Results, 1)
+	assert.Equal(t, &GenerativeResult{Text: "a pizza"}, reply.Results[0].Generative)
+	assert.Equal(t, &GenerativeResult{Text: "popular pizzas"}, reply.Generative)
+
+	t.Run("provider or debug", func(t *testing.T) {
+		req := NewGenerativeSearch().SingleResult("Describe {name}").GroupedResult("Why?").WithDebug().togrpc()
+		assert.Empty(t, req.SingleResponsePrompt)
+		assert.Empty(t, req.GroupedResponseTask)
+	})
+}
+
+// legacyGenerativeSearchServer replies like servers before v1.27
+type legacyGenerativeSearchServer struct {
+	pb.UnimplementedWeaviateServer
+	request *pb.SearchRequest
+}
+
+func (l *legacyGenerativeSearchServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	l.request = req
+	return &pb.SearchReply{
+		Results:                 []*pb.SearchResult{{Metadata: &pb.MetadataResult{Id: "1", Generative: "a pizza", GenerativePresent: true}}},
+		GenerativeGroupedResult: proto.String("popular pizzas"),
+	}, nil
+}
+
+func TestGenerativeProvider_togrpc(t *testing.T) {
+	tests := []struct {
+		provider *GenerativeProviderBuilder
+		expected *pb.GenerativeProvider
+	}{
+		{
+			provider: NewGenerativeProvider(GenerativeAnthropic).WithBaseURL("http://anthropic").WithTopP(0.5),
+			expected: &pb.GenerativeProvider{Kind: &pb.GenerativeProvider_Anthropic{Anthropic: &pb.GenerativeAnthropic{
+				BaseUrl: proto.String("http://anthropic"), TopP: proto.Float64(0.5),
+			}}},
+		},
+		{
+			provider: NewGenerativeProvider(GenerativeOllama).WithBaseURL("http://ollama").WithMaxTokens(10),
+			expected: &pb.GenerativeProvider{Kind: &pb.GenerativeProvider_Ollama{Ollama: &pb.GenerativeOllama{
+				ApiEndpoint: proto.String("http://ollama"),
+			}}},
+		},
+		{
+			provider: NewGenerativeProvider(GenerativeContextualAI).WithMaxTokens(10),
+			expected: &pb.GenerativeProvider{Kind: &pb.GenerativeProvider_Contextualai{Contextualai: &pb.GenerativeContextualAI{
+				MaxNewTokens: proto.Int64(10),
+			}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.provider.name, func(t *testing.T) {
+			actual := tt.provider.togrpc()
+			assert.True(t, proto.Equal(tt.expected, actual), "%v", actual)
+		})
+	}
+}
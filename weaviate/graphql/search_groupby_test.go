@@ -0,0 +1,70 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type groupBySearchServer struct {
+	pb.UnimplementedWeaviateServer
+	request *pb.SearchRequest
+}
+
+func (g *groupBySearchServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	g.request = req
+	object := func(id string, rerankScore float64) *pb.SearchResult {
+		return &pb.SearchResult{Metadata: &pb.MetadataResult{Id: id, RerankScore: rerankScore, RerankScorePresent: true}}
+	}
+	return &pb.SearchReply{GroupByResults: []*pb.GroupByResult{
+		{
+			Name: "Italy", MinDistance: 0.1, MaxDistance: 0.3, NumberOfObjects: 2,
+			Objects: []*pb.SearchResult{object("1", 0.9), object("2", 0.5)},
+			Rerank:  &pb.RerankReply{Score: 0.9},
+		},
+		{
+			Name: "France", MinDistance: 0.2, MaxDistance: 0.2, NumberOfObjects: 1,
+			Objects:          []*pb.SearchResult{object("3", 0.4)},
+			GenerativeResult: &pb.GenerativeResult{Values: []*pb.GenerativeReply{{Result: "french pizzas"}}},
+		},
+	}}, nil
+}
+
+func TestSearch_WithGroupBy(t *testing.T) {
+	server := &groupBySearchServer{}
+	groupBy := &GroupByArgumentBuilder{}
+	groupBy.WithPath([]string{"country"}).WithGroups(2).WithObjectsPerGroup(3)
+	reply, err := NewSearch(newTestGrpcClient(t, server)).WithCollection("Pizza").
+		WithNearText((&NearTextArgumentBuilder{}).WithConcepts([]string{"pizza"})).
+		WithGroupBy(groupBy).
+		WithRerank("name", "margherita").
+		DoWithReply(context.Background())
+	require.NoError(t, err)
+
+	assert.True(t, proto.Equal(&pb.GroupBy{Path: []string{"country"}, NumberOfGroups: 2, ObjectsPerGroup: 3}, server.request.GroupBy))
+	assert.True(t, proto.Equal(&pb.Rerank{Property: "name", Query: proto.String("margherita")}, server.request.Rerank))
+
+	assert.Empty(t, reply.Results)
+	require.Len(t, reply.Groups, 2)
+	italy := reply.Groups[0]
+	assert.Equal(t, "Italy", italy.Name)
+	assert.Equal(t, float32(0.1), italy.MinDistance)
+	assert.Equal(t, float32(0.3), italy.MaxDistance)
+	assert.Equal(t, int64(2), italy.NumberOfObjects)
+	assert.Equal(t, 0.9, italy.RerankScore)
+	require.Len(t, italy.Objects, 2)
+	assert.Equal(t, "2", italy.Objects[1].ID)
+	assert.Equal(t, 0.5, italy.Objects[1].Metadata.RerankScore)
+	assert.Nil(t, italy.Generative)
+	assert.Equal(t, &GenerativeResult{Text: "french pizzas"}, reply.Groups[1].Generative)
+}
+
+func TestSearch_WithRerank(t *testing.T) {
+	req := NewSearch(nil).WithRerank("name", "").togrpc()
+	assert.True(t, proto.Equal(&pb.Rerank{Property: "name"}, req.Rerank))
+	assert.Nil(t, NewSearch(nil).togrpc().Rerank)
+}
@@ -0,0 +1,63 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/grpc"
+)
+
+// fakeSearchServer returns up to 5 results, whose IDs are their position
+type fakeSearchServer struct {
+	pb.UnimplementedWeaviateServer
+	requests []*pb.SearchRequest
+}
+
+func (f *fakeSearchServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	f.requests = append(f.requests, req)
+	var after int
+	if req.After != "" {
+		fmt.Sscanf(req.After, "%d", &after)
+	}
+	reply := &pb.SearchReply{}
+	for i := after + 1; i <= min(after+int(req.Limit), 5); i++ {
+		reply.Results = append(reply.Results, &pb.SearchResult{Metadata: &pb.MetadataResult{Id: fmt.Sprint(i)}})
+	}
+	return reply, nil
+}
+
+func newTestGrpcClient(t *testing.T, server pb.WeaviateServer) *connection.GrpcClient {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	pb.RegisterWeaviateServer(grpcServer, server)
+	go grpcServer.Serve(listener)
+	t.Cleanup(grpcServer.Stop)
+	grpcClient, err := connection.NewGrpcClient(listener.Addr().String(), false, nil, nil, time.Second, 0, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { grpcClient.Close() })
+	return grpcClient
+}
+
+func TestSearch_All(t *testing.T) {
+	server := &fakeSearchServer{}
+	search := NewSearch(newTestGrpcClient(t, server)).WithCollection("Article").WithLimit(2).WithMetadata(&Metadata{Vector: true})
+	var ids []string
+	for result, err := range search.All(context.Background()) {
+		require.NoError(t, err)
+		ids = append(ids, result.ID)
+	}
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	require.Len(t, server.requests, 3)
+	assert.Equal(t, "4", server.requests[2].After)
+	assert.True(t, server.requests[0].Metadata.Uuid)
+	assert.True(t, server.requests[0].Metadata.Vector)
+}
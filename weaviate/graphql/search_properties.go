@@ -7,6 +7,7 @@ import (
 type Properties struct {
 	withProperties []string
 	withReferences []*Reference
+	withObjects    []*ObjectProperty
 }
 
 func (p *Properties) WithProperties(properties ...string) *Properties {
@@ -19,12 +20,25 @@ func (p *Properties) WithReferences(references ...*Reference) *Properties {
 	return p
 }
 
-// This method is lacking support for json object properties
-// it only supports non ref and reference properties
+// WithObject selects properties of a nested object property
+func (p *Properties) WithObject(name string, properties ...string) *Properties {
+	p.withObjects = append(p.withObjects, &ObjectProperty{Name: name, Properties: properties})
+	return p
+}
+
+// WithObjects selects properties of nested object properties, which can hold further objects
+func (p *Properties) WithObjects(objects ...*ObjectProperty) *Properties {
+	p.withObjects = append(p.withObjects, objects...)
+	return p
+}
+
 func (p *Properties) togrpc() *pb.PropertiesRequest {
 	props := &pb.PropertiesRequest{
 		NonRefProperties:          p.withProperties,
-		ReturnAllNonrefProperties: len(p.withProperties) == 0,
+		ReturnAllNonrefProperties: len(p.withProperties) == 0 && len(p.withObjects) == 0,
+	}
+	for _, object := range p.withObjects {
+		props.ObjectProperties = append(props.ObjectProperties, object.togrpc())
 	}
 	if len(p.withReferences) > 0 {
 		refProperties := make([]*pb.RefPropertiesRequest, len(p.withReferences))
@@ -36,6 +50,24 @@ func (p *Properties) togrpc() *pb.PropertiesRequest {
 	return props
 }
 
+// ObjectProperty selects properties of an object or object[] property
+type ObjectProperty struct {
+	Name       string
+	Properties []string
+	Objects    []*ObjectProperty
+}
+
+func (o *ObjectProperty) togrpc() *pb.ObjectPropertiesRequest {
+	object := &pb.ObjectPropertiesRequest{
+		PropName:            o.Name,
+		PrimitiveProperties: o.Properties,
+	}
+	for _, nested := range o.Objects {
+		object.ObjectProperties = append(object.ObjectProperties, nested.togrpc())
+	}
+	return object
+}
+
 type Reference struct {
 	TargetCollection  string
 	ReferenceProperty string
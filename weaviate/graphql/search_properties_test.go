@@ -0,0 +1,105 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestProperties_togrpc(t *testing.T) {
+	t.Run("all properties", func(t *testing.T) {
+		props := (&Properties{}).togrpc()
+		assert.True(t, props.ReturnAllNonrefProperties)
+	})
+
+	t.Run("object properties", func(t *testing.T) {
+		props := (&Properties{}).
+			WithProperties("title").
+			WithObject("address", "city", "zip").
+			WithObjects(&ObjectProperty{
+				Name:       "authors",
+				Properties: []string{"name"},
+				Objects:    []*ObjectProperty{{Name: "contact", Properties: []string{"email"}}},
+			}).
+			togrpc()
+		assert.True(t, proto.Equal(&pb.PropertiesRequest{
+			NonRefProperties: []string{"title"},
+			ObjectProperties: []*pb.ObjectPropertiesRequest{
+				{PropName: "address", PrimitiveProperties: []string{"city", "zip"}},
+				{
+					PropName:            "authors",
+					PrimitiveProperties: []string{"name"},
+					ObjectProperties:    []*pb.ObjectPropertiesRequest{{PropName: "contact", PrimitiveProperties: []string{"email"}}},
+				},
+			},
+		}, props), "%v", props)
+	})
+
+	t.Run("only object properties", func(t *testing.T) {
+		props := (&Properties{}).WithObject("address", "city").togrpc()
+		assert.False(t, props.ReturnAllNonrefProperties)
+	})
+}
+
+type nestedSearchServer struct {
+	pb.UnimplementedWeaviateServer
+	request *pb.SearchRequest
+}
+
+func (n *nestedSearchServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	n.request = req
+	text := func(s string) *pb.Value { return &pb.Value{Kind: &pb.Value_TextValue{TextValue: s}} }
+	contact := &pb.Properties{Fields: map[string]*pb.Value{"email": text("jane@example.com")}}
+	return &pb.SearchReply{Results: []*pb.SearchResult{{
+		Metadata: &pb.MetadataResult{Id: "1"},
+		Properties: &pb.PropertiesResult{NonRefProps: &pb.Properties{Fields: map[string]*pb.Value{
+			"address": {Kind: &pb.Value_ObjectValue{ObjectValue: &pb.Properties{Fields: map[string]*pb.Value{
+				"city": text("Amsterdam"),
+				"zip":  {Kind: &pb.Value_IntValue{IntValue: 1011}},
+			}}}},
+			"authors": {Kind: &pb.Value_ListValue{ListValue: &pb.ListValue{Kind: &pb.ListValue_ObjectValues{
+				ObjectValues: &pb.ObjectValues{Values: []*pb.Properties{{Fields: map[string]*pb.Value{
+					"name":    text("Jane"),
+					"contact": {Kind: &pb.Value_ObjectValue{ObjectValue: contact}},
+					"scores": {Kind: &pb.Value_ListValue{ListValue: &pb.ListValue{Kind: &pb.ListValue_IntValues{
+						IntValues: &pb.IntValues{Values: byteops.IntsToByteVector([]float64{1, 2})},
+					}}}},
+				}}}},
+			}}}},
+		}}},
+	}}}, nil
+}
+
+func TestSearch_WithObject(t *testing.T) {
+	server := &nestedSearchServer{}
+	results, err := NewSearch(newTestGrpcClient(t, server)).WithCollection("Article").
+		WithProperties("title").
+		WithObject("address", "city", "zip").
+		WithObjects(&ObjectProperty{
+			Name:       "authors",
+			Properties: []string{"name", "scores"},
+			Objects:    []*ObjectProperty{{Name: "contact", Properties: []string{"email"}}},
+		}).
+		Do(context.Background())
+	require.NoError(t, err)
+
+	objects := server.request.Properties.ObjectProperties
+	require.Len(t, objects, 2)
+	assert.Equal(t, "address", objects[0].PropName)
+	assert.Equal(t, "contact", objects[1].ObjectProperties[0].PropName)
+
+	require.Len(t, results, 1)
+	assert.Equal(t, map[string]any{
+		"address": map[string]any{"city": "Amsterdam", "zip": int64(1011)},
+		"authors": []any{map[string]any{
+			"name":    "Jane",
+			"contact": map[string]any{"email": "jane@example.com"},
+			"scores":  []int64{1, 2},
+		}},
+	}, results[0].Properties)
+}
@@ -1,11 +1,34 @@
 package graphql
 
 import (
+	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/models"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/byteops"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// SearchReply holds the results of a search and what the server returns for all of them
+type SearchReply struct {
+	Results []SearchResult
+	// Generative is the text generated for all results with a grouped task
+	Generative *GenerativeResult
+	// Groups of the results of a search with WithGroupBy
+	Groups []GroupResult
+}
+
+// GroupResult is a group of results sharing the value of the grouped by property
+type GroupResult struct {
+	Name                     string
+	MinDistance, MaxDistance float32
+	NumberOfObjects          int64
+	Objects                  []SearchResult
+	// RerankScore of the group, set with WithRerank
+	RerankScore float64
+	// Generative is the text generated for the objects of the group with a grouped task
+	Generative *GenerativeResult
+}
+
 type SearchResult struct {
 	ID         string
 	Collection string
@@ -14,6 +37,8 @@ type SearchResult struct {
 	Metadata   MetadataResult
 	Vector     []float32
 	Vectors    map[string]Vector
+	// Generative is the text generated for the result with a single prompt
+	Generative *GenerativeResult
 }
 
 type ReferenceResult struct {
@@ -60,6 +85,60 @@ func (v Vector) GetMultiVector() [][]float32 {
 	return nil
 }
 
+// Object converts the result to an object of its collection. Properties holding
+// lists, nested objects, geo coordinates or phone numbers are converted to the
+// values the REST API uses, references are not part of the object.
+func (r SearchResult) Object() *models.Object {
+	object := &models.Object{
+		ID:                 strfmt.UUID(r.ID),
+		Class:              r.Collection,
+		CreationTimeUnix:   r.Metadata.CreationTimeUnix,
+		LastUpdateTimeUnix: r.Metadata.LastUpdateTimeUnix,
+		Vector:             r.Vector,
+	}
+	if r.Properties != nil {
+		properties := make(map[string]any, len(r.Properties))
+		for name, value := range r.Properties {
+			properties[name] = plainValue(value)
+		}
+		object.Properties = properties
+	}
+	if len(r.Vectors) > 0 {
+		object.Vectors = make(models.Vectors, len(r.Vectors))
+		for name, vector := range r.Vectors {
+			object.Vectors[name] = vector.Vector
+		}
+	}
+	return object
+}
+
+func toReply(reply *pb.SearchReply) *SearchReply {
+	return &SearchReply{
+		Results:    toResults(reply.GetResults()),
+		Generative: extractGroupedGenerative(reply),
+		Groups:     toGroups(reply.GetGroupByResults()),
+	}
+}
+
+func toGroups(groups []*pb.GroupByResult) []GroupResult {
+	if len(groups) == 0 {
+		return nil
+	}
+	results := make([]GroupResult, len(groups))
+	for i, g := range groups {
+		results[i] = GroupResult{
+			Name:            g.GetName(),
+			MinDistance:     g.GetMinDistance(),
+			MaxDistance:     g.GetMaxDistance(),
+			NumberOfObjects: g.GetNumberOfObjects(),
+			Objects:         toResults(g.GetObjects()),
+			RerankScore:     g.GetRerank().GetScore(),
+			Generative:      extractGenerative(g.GetGenerativeResult()),
+		}
+	}
+	return results
+}
+
 func toResults(results []*pb.SearchResult) []SearchResult {
 	searchResults := make([]SearchResult, len(results))
 	for i, r := range results {
@@ -71,6 +150,7 @@ func toResults(results []*pb.SearchResult) []SearchResult {
 			Metadata:   extractMetadata(r.GetMetadata()),
 			Vector:     extractVector(r.GetMetadata()),
 			Vectors:    extractVectors(r.GetMetadata()),
+			Generative: extractSingleGenerative(r),
 		}
 	}
 	return searchResults
@@ -84,12 +164,27 @@ func extractProperties(p *pb.PropertiesResult) map[string]any {
 	properties := make(map[string]any)
 	if nonRefProps := p.GetNonRefProps(); nonRefProps != nil {
 		for name, val := range nonRefProps.GetFields() {
-			properties[name] = getValue(val)
+			properties[name] = propertyValue(val)
 		}
 	}
 	return properties
 }
 
+// propertyValue returns the value of a property, with nested objects and lists of
+// them converted to maps and slices of maps
+func propertyValue(val *pb.Value) any {
+	value := getValue(val)
+	switch v := value.(type) {
+	case *pb.Properties:
+		return plainProperties(v)
+	case *pb.ListValue:
+		if v.GetObjectValues() != nil {
+			return plainList(v)
+		}
+	}
+	return value
+}
+
 func extractReferences(p *pb.PropertiesResult) []ReferenceResult {
 	if p != nil {
 		if refProps := p.GetRefProps(); len(refProps) > 0 {
@@ -230,3 +325,62 @@ func getValue(val *pb.Value) any {
 		return nil
 	}
 }
+
+// plainValue converts the protobuf messages returned by getValue
+func plainValue(value any) any {
+	switch v := value.(type) {
+	case *pb.ListValue:
+		return plainList(v)
+	case *pb.Properties:
+		return plainProperties(v)
+	case *pb.GeoCoordinate:
+		return map[string]any{"latitude": v.GetLatitude(), "longitude": v.GetLongitude()}
+	case *pb.PhoneNumber:
+		return map[string]any{
+			"input":                  v.GetInput(),
+			"defaultCountry":         v.GetDefaultCountry(),
+			"countryCode":            v.GetCountryCode(),
+			"internationalFormatted": v.GetInternationalFormatted(),
+			"national":               v.GetNational(),
+			"nationalFormatted":      v.GetNationalFormatted(),
+			"valid":                  v.GetValid(),
+		}
+	case structpb.NullValue:
+		return nil
+	default:
+		return value
+	}
+}
+
+func plainList(l *pb.ListValue) any {
+	switch l.GetKind().(type) {
+	case *pb.ListValue_TextValues:
+		return l.GetTextValues().GetValues()
+	case *pb.ListValue_IntValues:
+		return byteops.IntsFromByteVector(l.GetIntValues().GetValues())
+	case *pb.ListValue_NumberValues:
+		return byteops.Fp64SliceFromBytes(l.GetNumberValues().GetValues())
+	case *pb.ListValue_BoolValues:
+		return l.GetBoolValues().GetValues()
+	case *pb.ListValue_DateValues:
+		return l.GetDateValues().GetValues()
+	case *pb.ListValue_UuidValues:
+		return l.GetUuidValues().GetValues()
+	case *pb.ListValue_ObjectValues:
+		objects := make([]any, len(l.GetObjectValues().GetValues()))
+		for i, o := range l.GetObjectValues().GetValues() {
+			objects[i] = plainProperties(o)
+		}
+		return objects
+	default:
+		return []any{}
+	}
+}
+
+func plainProperties(p *pb.Properties) map[string]any {
+	properties := make(map[string]any, len(p.GetFields()))
+	for name, value := range p.GetFields() {
+		properties[name] = plainValue(getValue(value))
+	}
+	return properties
+}
@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/typed"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// TypedResult is a search result decoded into a T
+type TypedResult[T any] struct {
+	Object   T
+	Metadata MetadataResult
+}
+
+// SearchTyped runs the search and decodes the results into Ts, structs mapped with
+// `weaviate` tags as described in package typed. Reference fields are filled from
+// the references requested with WithReferences.
+func SearchTyped[T any](ctx context.Context, search *Search) ([]TypedResult[T], error) {
+	results, err := search.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typedResults := make([]TypedResult[T], len(results))
+	for i, result := range results {
+		typedResults[i].Metadata = result.Metadata
+		if err := typed.Decode(result.properties(), &typedResults[i].Object); err != nil {
+			return nil, fmt.Errorf("result %d: %w", i, err)
+		}
+	}
+	return typedResults, nil
+}
+
+// properties returns the properties of the result in the shape of a GraphQL Get result,
+// with the ID and vectors as additional properties and references as lists of objects
+func (r SearchResult) properties() map[string]any {
+	object := r.Object()
+	properties, _ := object.Properties.(map[string]any)
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	properties["_additional"] = additionalProperties(r.ID, r.Vector, r.Vectors)
+	for _, ref := range r.References {
+		targets := make([]any, len(ref.ReferenceProperties))
+		for i, target := range ref.ReferenceProperties {
+			props := make(map[string]any, len(target.Properties)+1)
+			for name, value := range target.Properties {
+				props[name] = plainValue(value)
+			}
+			props["_additional"] = additionalProperties(target.Metadata.ID, target.Metadata.Vector, target.Metadata.Vectors)
+			targets[i] = props
+		}
+		properties[ref.Name] = targets
+	}
+	return properties
+}
+
+func additionalProperties(id string, vector []float32, vectors map[string]Vector) map[string]any {
+	additional := map[string]any{"id": id}
+	if len(vector) > 0 {
+		additional["vector"] = vector
+	}
+	if len(vectors) > 0 {
+		named := make(map[string]any, len(vectors))
+		for name, v := range vectors {
+			named[name] = v.Vector
+		}
+		additional["vectors"] = named
+	}
+	return additional
+}
+
+// DecodeGet decodes the objects of a class in the response of a Get query into Ts,
+// structs mapped with `weaviate` tags as described in package typed. The ID and
+// vectors are read from the _additional fields, if queried. Errors of the response
+// are returned as an error.
+func DecodeGet[T any](response *models.GraphQLResponse, className string) ([]T, error) {
+	if err := ResponseError(response); err != nil {
+		return nil, err
+	}
+	get, _ := response.Data["Get"].(map[string]any)
+	items, _ := get[className].([]any)
+	values := make([]T, len(items))
+	for i, item := range items {
+		properties, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("decode %s %d: unexpected %T", className, i, item)
+		}
+		if err := typed.Decode(properties, &values[i]); err != nil {
+			return nil, fmt.Errorf("decode %s %d: %w", className, i, err)
+		}
+	}
+	return values, nil
+}
+
+// ResponseError returns the errors of a GraphQL response as ResponseErrors, or nil if it has none
+func ResponseError(response *models.GraphQLResponse) error {
+	if response == nil {
+		return errors.New("graphql: no response")
+	}
+	if len(response.Errors) == 0 {
+		return nil
+	}
+	return ResponseErrors(response.Errors)
+}
@@ -0,0 +1,105 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+type typedAuthor struct {
+	ID   strfmt.UUID `weaviate:",id"`
+	Name string      `weaviate:"name"`
+}
+
+type typedArticle struct {
+	ID       strfmt.UUID            `weaviate:",id"`
+	Title    string                 `weaviate:"title"`
+	Tags     []string               `weaviate:"tags"`
+	Location *models.GeoCoordinates `weaviate:"location"`
+	Authors  []typedAuthor          `weaviate:"hasAuthors,ref"`
+	Vector   []float32              `weaviate:",vector"`
+}
+
+type typedSearchServer struct {
+	pb.UnimplementedWeaviateServer
+}
+
+func (typedSearchServer) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
+	return &pb.SearchReply{Results: []*pb.SearchResult{{
+		Metadata: &pb.MetadataResult{
+			Id:              "00000000-0000-0000-0000-000000000001",
+			VectorBytes:     byteops.Fp32SliceToBytes([]float32{1, 2}),
+			Distance:        0.25,
+			DistancePresent: true,
+		},
+		Properties: &pb.PropertiesResult{
+			NonRefProps: &pb.Properties{Fields: map[string]*pb.Value{
+				"title": {Kind: &pb.Value_TextValue{TextValue: "A"}},
+				"tags": {Kind: &pb.Value_ListValue{ListValue: &pb.ListValue{
+					Kind: &pb.ListValue_TextValues{TextValues: &pb.TextValues{Values: []string{"x"}}},
+				}}},
+				"location": {Kind: &pb.Value_GeoValue{GeoValue: &pb.GeoCoordinate{Latitude: 1.5, Longitude: 2}}},
+			}},
+			RefProps: []*pb.RefPropertiesResult{{
+				PropName: "hasAuthors",
+				Properties: []*pb.PropertiesResult{{
+					Metadata: &pb.MetadataResult{Id: "00000000-0000-0000-0000-00000000000a"},
+					NonRefProps: &pb.Properties{Fields: map[string]*pb.Value{
+						"name": {Kind: &pb.Value_TextValue{TextValue: "Jane"}},
+					}},
+				}},
+			}},
+		},
+	}}}, nil
+}
+
+func TestSearchTyped(t *testing.T) {
+	search := NewSearch(newTestGrpcClient(t, typedSearchServer{})).WithCollection("Article")
+	results, err := SearchTyped[typedArticle](context.Background(), search)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, float32(0.25), results[0].Metadata.Distance)
+	latitude, longitude := float32(1.5), float32(2)
+	assert.Equal(t, typedArticle{
+		ID:       "00000000-0000-0000-0000-000000000001",
+		Title:    "A",
+		Tags:     []string{"x"},
+		Location: &models.GeoCoordinates{Latitude: &latitude, Longitude: &longitude},
+		Authors:  []typedAuthor{{ID: "00000000-0000-0000-0000-00000000000a", Name: "Jane"}},
+		Vector:   []float32{1, 2},
+	}, results[0].Object)
+}
+
+func TestDecodeGet(t *testing.T) {
+	var response models.GraphQLResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"data": {"Get": {"Article": [
+		{"title": "A", "hasAuthors": [{"name": "Jane", "_additional": {"id": "00000000-0000-0000-0000-00000000000a"}}],
+		 "_additional": {"id": "00000000-0000-0000-0000-000000000001", "vector": [1, 2]}},
+		{"title": "B"}
+	]}}}`), &response))
+
+	articles, err := DecodeGet[typedArticle](&response, "Article")
+	require.NoError(t, err)
+	assert.Equal(t, []typedArticle{
+		{
+			ID:      "00000000-0000-0000-0000-000000000001",
+			Title:   "A",
+			Authors: []typedAuthor{{ID: "00000000-0000-0000-0000-00000000000a", Name: "Jane"}},
+			Vector:  []float32{1, 2},
+		},
+		{Title: "B"},
+	}, articles)
+
+	t.Run("errors", func(t *testing.T) {
+		response := &models.GraphQLResponse{Errors: []*models.GraphQLError{{Message: "no such class"}}}
+		_, err := DecodeGet[typedArticle](response, "Article")
+		assert.EqualError(t, err, "graphql: no such class")
+	})
+}
@@ -18,6 +18,13 @@ type KnownGroupLister struct {
 }
 
 func (r *KnownGroupLister) Do(ctx context.Context) (KnownGroupList, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "groups.KnownGroupLister")
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *KnownGroupLister) do(ctx context.Context) (KnownGroupList, error) {
 	res, err := r.connection.RunREST(ctx, r.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
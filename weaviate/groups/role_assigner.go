@@ -31,6 +31,13 @@ func (ra *RoleAssigner) WithRoles(roles ...string) *RoleAssigner {
 }
 
 func (ra *RoleAssigner) Do(ctx context.Context) error {
+	ctx, op := ra.connection.Telemetry().Start(ctx, "groups.RoleAssigner")
+	err := ra.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (ra *RoleAssigner) do(ctx context.Context) error {
 	payload := authz.AssignRoleToGroupBody{
 		Roles:     ra.roles,
 		GroupType: ra.groupType,
@@ -31,6 +31,13 @@ func (grg *GroupRolesGetter) WithIncludeFullRoles(include bool) *GroupRolesGette
 }
 
 func (grg *GroupRolesGetter) Do(ctx context.Context) ([]*rbac.Role, error) {
+	ctx, op := grg.connection.Telemetry().Start(ctx, "groups.GroupRolesGetter")
+	result, err := grg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (grg *GroupRolesGetter) do(ctx context.Context) ([]*rbac.Role, error) {
 	res, err := grg.connection.RunREST(ctx, grg.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
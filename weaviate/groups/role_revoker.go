@@ -31,6 +31,13 @@ func (ra *RoleRevoker) WithRoles(roles ...string) *RoleRevoker {
 }
 
 func (ra *RoleRevoker) Do(ctx context.Context) error {
+	ctx, op := ra.connection.Telemetry().Start(ctx, "groups.RoleRevoker")
+	err := ra.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (ra *RoleRevoker) do(ctx context.Context) error {
 	payload := authz.RevokeRoleFromGroupBody{
 		Roles:     ra.roles,
 		GroupType: ra.groupType,
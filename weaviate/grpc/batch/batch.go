@@ -308,6 +308,45 @@ func (b Batch) getCrossRefs(propName string, crossRefs map[string][]string) ([]*
 	return singleTargetRefProps, multiTargetRefProps
 }
 
+func (b Batch) GetBatchReferences(references []*models.BatchReference) ([]*pb.BatchReference, error) {
+	result := make([]*pb.BatchReference, len(references))
+	for i, ref := range references {
+		if ref == nil {
+			return nil, fmt.Errorf("reference at index %d is nil", i)
+		}
+		source, err := crossref.ParseSource(ref.From.String())
+		if err != nil {
+			return nil, fmt.Errorf("reference at index %d: %w", i, err)
+		}
+		target, err := crossref.Parse(ref.To.String())
+		if err != nil {
+			return nil, fmt.Errorf("reference at index %d: %w", i, err)
+		}
+		batchReference := &pb.BatchReference{
+			Name:           source.Property.String(),
+			FromCollection: source.Class.String(),
+			FromUuid:       source.TargetID.String(),
+			ToUuid:         target.TargetID.String(),
+			Tenant:         ref.Tenant,
+		}
+		if target.Class != "" {
+			batchReference.ToCollection = &target.Class
+		}
+		result[i] = batchReference
+	}
+	return result, nil
+}
+
+// Beacon returns the beacon the server uses to identify ref in batch stream replies,
+// i.e. the beacon of the reference's source property.
+func Beacon(ref *models.BatchReference) (string, error) {
+	source, err := crossref.ParseSource(ref.From.String())
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("weaviate://localhost/%s/%s/%s", source.Class, source.TargetID, source.Property), nil
+}
+
 func (b Batch) GetConsistencyLevel(consistencyLevel string) *pb.ConsistencyLevel {
 	return common.GetConsistencyLevel(consistencyLevel)
 }
@@ -343,6 +382,31 @@ func (b Batch) ParseReply(reply *pb.BatchObjectsReply, objects []*models.Object)
 	return result
 }
 
+func (b Batch) ParseReferencesReply(reply *pb.BatchReferencesReply, references []*models.BatchReference) []models.BatchReferenceResponse {
+	errors := map[int]string{}
+	if reply != nil {
+		for _, res := range reply.Errors {
+			errors[int(res.Index)] = res.Error
+		}
+	}
+	result := make([]models.BatchReferenceResponse, len(references))
+	for i, ref := range references {
+		status := models.BatchReferenceResponseAO1ResultStatusSUCCESS
+		var errorResponse *models.ErrorResponse
+		if err, ok := errors[i]; ok {
+			status = models.BatchReferenceResponseAO1ResultStatusFAILED
+			errorResponse = &models.ErrorResponse{
+				Error: []*models.ErrorResponseErrorItems0{{Message: err}},
+			}
+		}
+		result[i] = models.BatchReferenceResponse{
+			BatchReference: *ref,
+			Result:         &models.BatchReferenceResponseAO1Result{Errors: errorResponse, Status: &status},
+		}
+	}
+	return result
+}
+
 func (b Batch) getErrorGetResponse(res *pb.BatchObjectsReply_BatchError) *models.ObjectsGetResponseAO2Result {
 	var errors *models.ErrorResponse
 	if res.Error != "" {
@@ -1,6 +1,13 @@
 package grpc
 
-import "google.golang.org/grpc/keepalive"
+import (
+	"crypto/tls"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
+)
 
 type Config struct {
 	// Secured set it to true if it's a secured connection
@@ -9,6 +16,45 @@ type Config struct {
 	// If host is without a port number then the 80 port
 	// for insecured and 443 port for secured connections will be used.
 	Host string
+	// Hosts of further Weaviate nodes, calls are spread across Host and Hosts
+	// according to the client's load balancing config.
+	Hosts []string
 	// Keepalive parameters for the gRPC connection.
 	Keepalive *keepalive.ClientParameters
+	// Retry policy for gRPC calls. If omitted, calls are not retried.
+	Retry *RetryConfig
+	// ConnectParams control the backoff used when (re)connecting to Weaviate,
+	// e.g. while a node is restarting. If omitted, gRPC defaults are used.
+	ConnectParams *grpc.ConnectParams
+	// TLSConfig of secured connections, e.g. custom root CAs, client certificates
+	// for mTLS or a server name override. Setting it implies Secured.
+	// If omitted, the server certificate is verified against the system's root CAs.
+	TLSConfig *tls.Config
+}
+
+// DefaultRetryableCodes are retried if RetryConfig.Codes is empty.
+var DefaultRetryableCodes = []codes.Code{codes.Unavailable, codes.ResourceExhausted}
+
+// RetryConfig of the retry policy applied to gRPC calls.
+type RetryConfig struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Defaults to 3, set it to 1 to disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, it is doubled
+	// with every subsequent attempt. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts. Defaults to 5s.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0..1) of the backoff which is randomized.
+	Jitter float64
+	// Codes lists the status codes which are retried. Defaults to DefaultRetryableCodes.
+	Codes []codes.Code
+	// PerAttemptTimeout limits the duration of a single attempt. An attempt which
+	// times out is retried as long as the overall deadline has not expired.
+	// If omitted, every attempt may use the whole client timeout.
+	PerAttemptTimeout time.Duration
+	// HedgingDelay enables hedging for read-only calls (Search): if an attempt
+	// has not completed after HedgingDelay, another one is started in parallel
+	// and the first successful reply wins. At most MaxAttempts calls are made.
+	HedgingDelay time.Duration
 }
@@ -0,0 +1,68 @@
+package loadbalance
+
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Policy selects the node a read request is sent to.
+type Policy string
+
+const (
+	// RoundRobin cycles through the healthy nodes.
+	RoundRobin Policy = "round_robin"
+	// LeastInFlight picks the healthy node with the fewest requests in flight.
+	LeastInFlight Policy = "least_in_flight"
+)
+
+const (
+	defaultProbeInterval    = 10 * time.Second
+	defaultFailureThreshold = 3
+)
+
+// Config of the load balancing across multiple Weaviate nodes. Read requests
+// (GET, HEAD and GraphQL queries) are spread across all healthy nodes, all other
+// requests go to the first healthy node in the order the hosts were given.
+type Config struct {
+	// Policy used to spread read requests, defaults to RoundRobin.
+	Policy Policy
+	// ProbeInterval between two readiness probes (/.well-known/ready) of every node.
+	// Defaults to 10s.
+	ProbeInterval time.Duration
+	// FailureThreshold is the number of consecutive failed requests after which a node is
+	// ejected until a readiness probe succeeds again. Defaults to 3.
+	FailureThreshold int
+	// DiscoverNodes enables discovery of the nodes of the cluster with the nodes API.
+	// It maps every node to its REST host and gRPC host (host[:port]); empty hosts are skipped.
+	// Node names are not addresses, so the mapping depends on the deployment, e.g. on Kubernetes:
+	//
+	//	func(node *models.NodeStatus) (string, string) {
+	//		return node.Name + ".weaviate-headless:8080", node.Name + ".weaviate-headless:50051"
+	//	}
+	DiscoverNodes func(node *models.NodeStatus) (host string, grpcHost string)
+}
+
+// GetPolicy returns the configured policy or the default one.
+func (c *Config) GetPolicy() Policy {
+	if c == nil || c.Policy == "" {
+		return RoundRobin
+	}
+	return c.Policy
+}
+
+// GetProbeInterval returns the configured probe interval or the default one.
+func (c *Config) GetProbeInterval() time.Duration {
+	if c == nil || c.ProbeInterval <= 0 {
+		return defaultProbeInterval
+	}
+	return c.ProbeInterval
+}
+
+// GetFailureThreshold returns the configured failure threshold or the default one.
+func (c *Config) GetFailureThreshold() int {
+	if c == nil || c.FailureThreshold <= 0 {
+		return defaultFailureThreshold
+	}
+	return c.FailureThreshold
+}
@@ -16,6 +16,13 @@ type MetaGetter struct {
 
 // Do get the meta endpoint
 func (mg *MetaGetter) Do(ctx context.Context) (*models.Meta, error) {
+	ctx, op := mg.connection.Telemetry().Start(ctx, "misc.MetaGetter")
+	result, err := mg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (mg *MetaGetter) do(ctx context.Context) (*models.Meta, error) {
 	responseData, responseErr := mg.connection.RunREST(ctx, "/meta", http.MethodGet, nil)
 	err := except.CheckResponseDataErrorAndStatusCode(responseData, responseErr, 200)
 	if err != nil {
@@ -25,6 +25,13 @@ type ReadyChecker struct {
 
 // Do the ready request
 func (rc *ReadyChecker) Do(ctx context.Context) (bool, error) {
+	ctx, op := rc.connection.Telemetry().Start(ctx, "misc.ReadyChecker")
+	result, err := rc.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (rc *ReadyChecker) do(ctx context.Context) (bool, error) {
 	response, err := rc.connection.RunREST(ctx, "/.well-known/ready", http.MethodGet, nil)
 	if err != nil {
 		return false, except.NewDerivedWeaviateClientError(err)
@@ -44,6 +51,13 @@ type LiveChecker struct {
 
 // Do the LiveChecker request
 func (lc *LiveChecker) Do(ctx context.Context) (bool, error) {
+	ctx, op := lc.connection.Telemetry().Start(ctx, "misc.LiveChecker")
+	result, err := lc.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (lc *LiveChecker) do(ctx context.Context) (bool, error) {
 	response, err := lc.connection.RunREST(ctx, "/.well-known/live", http.MethodGet, nil)
 	if err != nil {
 		return false, except.NewDerivedWeaviateClientError(err)
@@ -62,6 +76,13 @@ type OpenIDConfigGetter struct {
 
 // Do the open ID config request
 func (oidcg *OpenIDConfigGetter) Do(ctx context.Context) (*OpenIDConfiguration, error) {
+	ctx, op := oidcg.connection.Telemetry().Start(ctx, "misc.OpenIDConfigGetter")
+	result, err := oidcg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (oidcg *OpenIDConfigGetter) do(ctx context.Context) (*OpenIDConfiguration, error) {
 	response, err := oidcg.connection.RunREST(ctx, "/.well-known/openid-configuration", http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
@@ -21,6 +21,13 @@ func (aug *AssignedUsersGetter) WithRole(role string) *AssignedUsersGetter {
 }
 
 func (aug *AssignedUsersGetter) Do(ctx context.Context) ([]string, error) {
+	ctx, op := aug.connection.Telemetry().Start(ctx, "rbac.AssignedUsersGetter")
+	result, err := aug.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (aug *AssignedUsersGetter) do(ctx context.Context) ([]string, error) {
 	res, err := aug.connection.RunREST(ctx, aug.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
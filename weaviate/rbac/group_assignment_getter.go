@@ -22,6 +22,13 @@ func (aug *GroupAssignmentGetter) WithRole(role string) *GroupAssignmentGetter {
 }
 
 func (aug *GroupAssignmentGetter) Do(ctx context.Context) ([]GroupAssignment, error) {
+	ctx, op := aug.connection.Telemetry().Start(ctx, "rbac.GroupAssignmentGetter")
+	result, err := aug.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (aug *GroupAssignmentGetter) do(ctx context.Context) ([]GroupAssignment, error) {
 	res, err := aug.connection.RunREST(ctx, aug.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
@@ -29,6 +29,13 @@ func (pa *PermissionAdder) WithPermissions(permissions ...Permission) *Permissio
 }
 
 func (pa *PermissionAdder) Do(ctx context.Context) error {
+	ctx, op := pa.connection.Telemetry().Start(ctx, "rbac.PermissionAdder")
+	err := pa.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (pa *PermissionAdder) do(ctx context.Context) error {
 	res, err := pa.connection.RunREST(ctx, pa.path(), http.MethodPost, authz.AddPermissionsBody{
 		Permissions: pa.role.makeWeaviatePermissions(),
 	})
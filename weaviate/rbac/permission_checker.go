@@ -28,8 +28,15 @@ func (pc *PermissionChecker) WithPermission(permission Permission) *PermissionCh
 }
 
 func (pc *PermissionChecker) Do(ctx context.Context) (bool, error) {
+	ctx, op := pc.connection.Telemetry().Start(ctx, "rbac.PermissionChecker")
+	result, err := pc.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (pc *PermissionChecker) do(ctx context.Context) (bool, error) {
 	checkPermission := pc.role.makeWeaviatePermissions()[0]
-	res, err := pc.connection.RunREST(ctx, pc.path(), http.MethodPost, checkPermission)
+	res, err := pc.connection.RunREST(connection.WithRetryNonIdempotent(ctx), pc.path(), http.MethodPost, checkPermission)
 	if err != nil {
 		return false, except.NewDerivedWeaviateClientError(err)
 	}
@@ -29,6 +29,13 @@ func (pc *PermissionRemover) WithPermissions(permissions ...Permission) *Permiss
 }
 
 func (pr *PermissionRemover) Do(ctx context.Context) error {
+	ctx, op := pr.connection.Telemetry().Start(ctx, "rbac.PermissionRemover")
+	err := pr.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (pr *PermissionRemover) do(ctx context.Context) error {
 	res, err := pr.connection.RunREST(ctx, pr.path(), http.MethodPost, authz.RemovePermissionsBody{
 		Permissions: pr.role.makeWeaviatePermissions(),
 	})
@@ -14,6 +14,13 @@ type RoleAllGetter struct {
 }
 
 func (rag *RoleAllGetter) Do(ctx context.Context) ([]Role, error) {
+	ctx, op := rag.connection.Telemetry().Start(ctx, "rbac.RoleAllGetter")
+	result, err := rag.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (rag *RoleAllGetter) do(ctx context.Context) ([]Role, error) {
 	res, err := rag.connection.RunREST(ctx, "/authz/roles", http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
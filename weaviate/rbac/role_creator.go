@@ -21,6 +21,13 @@ func (rc *RoleCreator) WithRole(role Role) *RoleCreator {
 }
 
 func (rc *RoleCreator) Do(ctx context.Context) error {
+	ctx, op := rc.connection.Telemetry().Start(ctx, "rbac.RoleCreator")
+	err := rc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (rc *RoleCreator) do(ctx context.Context) error {
 	res, err := rc.connection.RunREST(ctx, "/authz/roles", http.MethodPost, &models.Role{
 		Name:        &rc.role.Name,
 		Permissions: rc.role.makeWeaviatePermissions(),
@@ -20,6 +20,13 @@ func (rc *RoleDeleter) WithName(name string) *RoleDeleter {
 }
 
 func (rc *RoleDeleter) Do(ctx context.Context) error {
+	ctx, op := rc.connection.Telemetry().Start(ctx, "rbac.RoleDeleter")
+	err := rc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (rc *RoleDeleter) do(ctx context.Context) error {
 	res, err := rc.connection.RunREST(ctx, "/authz/roles/"+rc.name, http.MethodDelete, nil)
 	if err != nil {
 		return except.NewDerivedWeaviateClientError(err)
@@ -17,6 +17,13 @@ func (re *RoleExists) WithName(name string) *RoleExists {
 }
 
 func (re *RoleExists) Do(ctx context.Context) (bool, error) {
+	ctx, op := re.connection.Telemetry().Start(ctx, "rbac.RoleExists")
+	result, err := re.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (re *RoleExists) do(ctx context.Context) (bool, error) {
 	_, err := re.getter.Do(ctx)
 	return err == nil, err
 }
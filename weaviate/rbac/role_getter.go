@@ -20,6 +20,13 @@ func (rg *RoleGetter) WithName(name string) *RoleGetter {
 }
 
 func (rg *RoleGetter) Do(ctx context.Context) (Role, error) {
+	ctx, op := rg.connection.Telemetry().Start(ctx, "rbac.RoleGetter")
+	result, err := rg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (rg *RoleGetter) do(ctx context.Context) (Role, error) {
 	res, err := rg.connection.RunREST(ctx, "/authz/roles/"+rg.name, http.MethodGet, nil)
 	if err != nil {
 		return Role{}, except.NewDerivedWeaviateClientError(err)
@@ -22,6 +22,13 @@ func (aug *UserAssignmentGetter) WithRole(role string) *UserAssignmentGetter {
 }
 
 func (aug *UserAssignmentGetter) Do(ctx context.Context) ([]UserAssignment, error) {
+	ctx, op := aug.connection.Telemetry().Start(ctx, "rbac.UserAssignmentGetter")
+	result, err := aug.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (aug *UserAssignmentGetter) do(ctx context.Context) ([]UserAssignment, error) {
 	res, err := aug.connection.RunREST(ctx, aug.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
@@ -0,0 +1,175 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+)
+
+// DefaultRetryableStatusCodes are retried if Config.RetryableStatusCodes is empty.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// Config of the retry policy applied to requests sent to Weaviate.
+// A nil *Config disables retries.
+type Config struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Defaults to 3, set it to 1 to disable retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry, it is doubled
+	// with every subsequent attempt. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including the delay
+	// requested by a Retry-After header. Defaults to 5s.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0..1) of the backoff which is randomized,
+	// e.g. 0.2 results in a delay between 80% and 100% of the backoff.
+	Jitter float64
+	// RetryableStatusCodes lists HTTP status codes which are retried.
+	// Defaults to DefaultRetryableStatusCodes.
+	RetryableStatusCodes []int
+	// RetryableError decides whether a transport error is retried.
+	// Defaults to IsTransientError.
+	RetryableError func(err error) bool
+}
+
+// Attempts returns the total number of attempts allowed by the policy.
+func (c *Config) Attempts() int {
+	if c == nil {
+		return 1
+	}
+	if c.MaxAttempts <= 0 {
+		return defaultMaxAttempts
+	}
+	return c.MaxAttempts
+}
+
+// Enabled reports whether the policy allows more than one attempt.
+func (c *Config) Enabled() bool {
+	return c.Attempts() > 1
+}
+
+// Backoff returns the delay to wait after the given (1-based) failed attempt.
+func (c *Config) Backoff(attempt int) time.Duration {
+	initial, maxBackoff := defaultInitialBackoff, c.maxBackoff()
+	if c != nil && c.InitialBackoff > 0 {
+		initial = c.InitialBackoff
+	}
+	delay := initial
+	for i := 1; i < attempt && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, maxBackoff)
+	if c != nil && c.Jitter > 0 {
+		jitter := min(c.Jitter, 1)
+		delay -= time.Duration(jitter * rand.Float64() * float64(delay))
+	}
+	return delay
+}
+
+// RetryAfterDelay returns the delay to wait after the given (1-based) failed attempt
+// whose response had the given Retry-After header value. The delay requested by the
+// server is capped at MaxBackoff, Backoff is used if the header is missing or invalid.
+func (c *Config) RetryAfterDelay(value string, attempt int) time.Duration {
+	delay, ok := RetryAfter(value)
+	if !ok {
+		return c.Backoff(attempt)
+	}
+	return min(delay, c.maxBackoff())
+}
+
+func (c *Config) maxBackoff() time.Duration {
+	if c != nil && c.MaxBackoff > 0 {
+		return c.MaxBackoff
+	}
+	return defaultMaxBackoff
+}
+
+// IsRetryableStatusCode reports whether a response with the given status code should be retried.
+func (c *Config) IsRetryableStatusCode(statusCode int) bool {
+	if c == nil {
+		return false
+	}
+	if len(c.RetryableStatusCodes) == 0 {
+		return slices.Contains(DefaultRetryableStatusCodes, statusCode)
+	}
+	return slices.Contains(c.RetryableStatusCodes, statusCode)
+}
+
+// IsRetryableError reports whether a request which failed with err should be retried.
+func (c *Config) IsRetryableError(err error) bool {
+	if c == nil || err == nil {
+		return false
+	}
+	if c.RetryableError != nil {
+		return c.RetryableError(err)
+	}
+	return IsTransientError(err)
+}
+
+// IsTransientError reports whether err is a network error which is likely to
+// succeed on a subsequent attempt, e.g. a connection reset or refused connection.
+// Context cancellation and deadline errors are never transient.
+func IsTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// RetryAfter parses the value of a Retry-After header, which is either
+// a number of seconds or an HTTP date. It returns false if the value is missing or invalid.
+func RetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// Wait blocks for the given delay. It returns an error without waiting if the
+// context's deadline would expire before the delay elapses, or if the context is done.
+func Wait(ctx context.Context, delay time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return context.DeadlineExceeded
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,96 @@
+package retry
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_Backoff(t *testing.T) {
+	c := &Config{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(t, 100*time.Millisecond, c.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, c.Backoff(2))
+	assert.Equal(t, 400*time.Millisecond, c.Backoff(3))
+	assert.Equal(t, time.Second, c.Backoff(10))
+
+	c.Jitter = 0.5
+	for range 100 {
+		delay := c.Backoff(2)
+		assert.GreaterOrEqual(t, delay, 100*time.Millisecond)
+		assert.LessOrEqual(t, delay, 200*time.Millisecond)
+	}
+}
+
+func TestConfig_Defaults(t *testing.T) {
+	var disabled *Config
+	assert.Equal(t, 1, disabled.Attempts())
+	assert.False(t, disabled.Enabled())
+	assert.False(t, disabled.IsRetryableStatusCode(http.StatusServiceUnavailable))
+
+	c := &Config{}
+	assert.Equal(t, defaultMaxAttempts, c.Attempts())
+	assert.True(t, c.IsRetryableStatusCode(http.StatusTooManyRequests))
+	assert.False(t, c.IsRetryableStatusCode(http.StatusInternalServerError))
+
+	c.RetryableStatusCodes = []int{http.StatusInternalServerError}
+	assert.True(t, c.IsRetryableStatusCode(http.StatusInternalServerError))
+	assert.False(t, c.IsRetryableStatusCode(http.StatusTooManyRequests))
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: nil, want: false},
+		{err: fmt.Errorf("read: %w", syscall.ECONNRESET), want: true},
+		{err: fmt.Errorf("dial: %w", syscall.ECONNREFUSED), want: true},
+		{err: io.ErrUnexpectedEOF, want: true},
+		{err: context.Canceled, want: false},
+		{err: fmt.Errorf("request: %w", context.DeadlineExceeded), want: false},
+		{err: fmt.Errorf("bad request"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v", tt.err), func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTransientError(tt.err))
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	delay, ok := RetryAfter("3")
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, delay)
+
+	delay, ok = RetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.InDelta(t, time.Minute, delay, float64(2*time.Second))
+
+	_, ok = RetryAfter("")
+	assert.False(t, ok)
+	_, ok = RetryAfter("soon")
+	assert.False(t, ok)
+}
+
+func TestConfig_RetryAfterDelay(t *testing.T) {
+	config := &Config{InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Second}
+	assert.Equal(t, time.Second, config.RetryAfterDelay("1", 1))
+	assert.Equal(t, 2*time.Second, config.RetryAfterDelay("3600", 1))
+	assert.Equal(t, 2*time.Millisecond, config.RetryAfterDelay("", 2))
+
+	var defaults *Config
+	assert.Equal(t, 5*time.Second, defaults.RetryAfterDelay("60", 1))
+}
+
+func TestWait(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, Wait(ctx, time.Second), context.DeadlineExceeded)
+	assert.NoError(t, Wait(context.Background(), time.Millisecond))
+}
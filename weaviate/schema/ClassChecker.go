@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // ClassExistenceChecker builder to check if a class is part of a weaviate schema
@@ -23,6 +24,14 @@ func (cd *ClassExistenceChecker) WithClassName(className string) *ClassExistence
 
 // Do check if the class is part of the weaviate schema
 func (cd *ClassExistenceChecker) Do(ctx context.Context) (bool, error) {
+	ctx, op := cd.connection.Telemetry().Start(ctx, "schema.ClassExistenceChecker",
+		telemetry.Collection(cd.className))
+	result, err := cd.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (cd *ClassExistenceChecker) do(ctx context.Context) (bool, error) {
 	responseData, err := cd.connection.RunREST(ctx, fmt.Sprintf("/schema/%s", cd.className), http.MethodGet, nil)
 	if err != nil {
 		return false, except.NewDerivedWeaviateClientError(err)
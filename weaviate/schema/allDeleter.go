@@ -15,6 +15,13 @@ type AllDeleter struct {
 
 // Do deletes all schema classes from weaviate
 func (ad *AllDeleter) Do(ctx context.Context) error {
+	ctx, op := ad.connection.Telemetry().Start(ctx, "schema.AllDeleter")
+	err := ad.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (ad *AllDeleter) do(ctx context.Context) error {
 	schema, getSchemaErr := ad.schemaAPI.Getter().Do(ctx)
 	if getSchemaErr != nil {
 		return except.NewDerivedWeaviateClientError(getSchemaErr)
@@ -8,6 +8,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/internal"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -26,9 +27,25 @@ func (cc *ClassCreator) WithClass(class *models.Class) *ClassCreator {
 
 // Do create a class in the schema as specified in the builder
 func (cc *ClassCreator) Do(ctx context.Context) error {
+	ctx, op := cc.connection.Telemetry().Start(ctx, "schema.ClassCreator",
+		telemetry.Collection(classNameOf(cc.class)))
+	err := cc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (cc *ClassCreator) do(ctx context.Context) error {
 	if err := internal.CheckTextAnalyzerSupport(cc.dbVersionProvider, cc.class); err != nil {
 		return err
 	}
 	responseData, err := cc.connection.RunREST(ctx, "/schema", http.MethodPost, cc.class)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
 }
+
+// classNameOf returns the name of class, or "" if class is nil
+func classNameOf(class *models.Class) string {
+	if class == nil {
+		return ""
+	}
+	return class.Class
+}
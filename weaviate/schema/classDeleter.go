@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // ClassDeleter builder to remove a class from weaviate
@@ -23,6 +24,13 @@ func (cd *ClassDeleter) WithClassName(className string) *ClassDeleter {
 
 // Do delete the class from the weaviate schema
 func (cd *ClassDeleter) Do(ctx context.Context) error {
+	ctx, op := cd.connection.Telemetry().Start(ctx, "schema.ClassDeleter", telemetry.Collection(cd.className))
+	err := cd.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (cd *ClassDeleter) do(ctx context.Context) error {
 	path := fmt.Sprintf("/schema/%v", cd.className)
 	responseData, err := cd.connection.RunREST(ctx, path, http.MethodDelete, nil)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -24,6 +25,13 @@ func (c *ClassGetter) WithClassName(className string) *ClassGetter {
 
 // Do get a class from schema as specified in the builder
 func (c *ClassGetter) Do(ctx context.Context) (*models.Class, error) {
+	ctx, op := c.connection.Telemetry().Start(ctx, "schema.ClassGetter", telemetry.Collection(c.className))
+	result, err := c.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (c *ClassGetter) do(ctx context.Context) (*models.Class, error) {
 	responseData, err := c.connection.RunREST(ctx, fmt.Sprintf("/schema/%s", c.className), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
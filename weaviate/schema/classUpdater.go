@@ -9,6 +9,7 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/db"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/internal"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -27,6 +28,14 @@ func (cu *ClassUpdater) WithClass(class *models.Class) *ClassUpdater {
 
 // Do create a class in the schema as specified in the builder
 func (cu *ClassUpdater) Do(ctx context.Context) error {
+	ctx, op := cu.connection.Telemetry().Start(ctx, "schema.ClassUpdater",
+		telemetry.Collection(classNameOf(cu.class)))
+	err := cu.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (cu *ClassUpdater) do(ctx context.Context) error {
 	if cu.class == nil || cu.class.Class == "" {
 		return except.NewWeaviateClientError(0, "A class must be provided")
 	}
@@ -0,0 +1,437 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// SchemaDiff holds the differences between two schemas, A and B
+type SchemaDiff struct {
+	// OnlyInA and OnlyInB name the classes which only one schema holds
+	OnlyInA, OnlyInB []string
+	// Classes which both schemas hold but which differ
+	Classes []ClassDiff
+}
+
+// Empty reports whether both schemas are the same
+func (d *SchemaDiff) Empty() bool {
+	return len(d.OnlyInA) == 0 && len(d.OnlyInB) == 0 && len(d.Classes) == 0
+}
+
+// ClassDiff holds the differences of a class
+type ClassDiff struct {
+	Class       string
+	Differences []Difference
+}
+
+// Difference of a setting between schema A and B. A setting which only one schema
+// holds has a nil value in the other.
+type Difference struct {
+	// Section of the class: class, properties, vectorConfig, vectorIndexConfig, shardingConfig,
+	// replicationConfig, invertedIndexConfig, multiTenancyConfig or moduleConfig
+	Section string
+	// Path of the setting, e.g. "properties.title.tokenization"
+	Path string
+	A, B any
+}
+
+func (d Difference) String() string {
+	return fmt.Sprintf("%s: %v != %v", d.Path, d.A, d.B)
+}
+
+// ignored marks settings which are not compared, as they depend on the cluster
+// rather than on the schema
+var ignored = &struct{}{}
+
+// anyKey holds the defaults of the settings under any key, such as the modules of moduleConfig
+const anyKey = "*"
+
+// hnswDefaults are the server defaults of the settings of HNSW vector indexes
+var hnswDefaults = map[string]any{
+	"distance":               "cosine",
+	"ef":                     -1.0,
+	"efConstruction":         128.0,
+	"maxConnections":         32.0,
+	"dynamicEfMin":           100.0,
+	"dynamicEfMax":           500.0,
+	"dynamicEfFactor":        8.0,
+	"flatSearchCutoff":       40000.0,
+	"cleanupIntervalSeconds": 300.0,
+	"vectorCacheMaxObjects":  1e12,
+	"skip":                   false,
+	"filterStrategy":         "acorn",
+	"pq": map[string]any{
+		"enabled":        false,
+		"bitCompression": false,
+		"segments":       0.0,
+		"centroids":      256.0,
+		"trainingLimit":  100000.0,
+		"encoder":        map[string]any{"type": "kmeans", "distribution": "log-normal"},
+	},
+	"bq": map[string]any{"enabled": false},
+	"sq": map[string]any{"enabled": false, "trainingLimit": 100000.0, "rescoreLimit": 20.0},
+	"rq": map[string]any{"enabled": false, "bits": 8.0, "rescoreLimit": 20.0},
+	"multivector": map[string]any{
+		"enabled":     false,
+		"aggregation": "maxSim",
+		"muvera": map[string]any{
+			"enabled":      false,
+			"ksim":         4.0,
+			"dprojections": 16.0,
+			"repetitions":  10.0,
+		},
+	},
+	"skipDefaultQuantization":  false,
+	"trackDefaultQuantization": ignored,
+}
+
+// classDefaults are the settings the server sets for classes which do not set them
+var classDefaults = map[string]any{
+	"vectorizer":        "none",
+	"vectorIndexType":   "hnsw",
+	"vectorIndexConfig": hnswDefaults,
+	"invertedIndexConfig": map[string]any{
+		"bm25":                   map[string]any{"b": 0.75, "k1": 1.2},
+		"stopwords":              map[string]any{"preset": "en"},
+		"cleanupIntervalSeconds": 60.0,
+		"indexTimestamps":        false,
+		"indexNullState":         false,
+		"indexPropertyLength":    false,
+		"usingBlockMaxWAND":      true,
+	},
+	"replicationConfig": map[string]any{
+		"factor":           1.0,
+		"deletionStrategy": "NoAutomatedResolution",
+	},
+	"shardingConfig": map[string]any{
+		"virtualPerPhysical":  128.0,
+		"desiredVirtualCount": 128.0,
+		"strategy":            "hash",
+		"key":                 "_id",
+		"function":            "murmur3",
+		"actualCount":         ignored,
+		"actualVirtualCount":  ignored,
+		"desiredCount":        ignored,
+	},
+	"moduleConfig": map[string]any{
+		anyKey: map[string]any{"vectorizeClassName": true},
+	},
+}
+
+var vectorConfigDefaults = map[string]any{
+	"vectorIndexType":   "hnsw",
+	"vectorIndexConfig": hnswDefaults,
+}
+
+// propertyDefaults are the settings the server sets for properties of a data type
+func propertyDefaults(dataType any) map[string]any {
+	text := false
+	if types, ok := dataType.([]any); ok && len(types) > 0 {
+		text = types[0] == "text" || types[0] == "text[]"
+	}
+	defaults := map[string]any{
+		"indexFilterable":   true,
+		"indexSearchable":   text,
+		"indexRangeFilters": false,
+		"moduleConfig": map[string]any{
+			anyKey: map[string]any{"skip": false, "vectorizePropertyName": false},
+		},
+	}
+	if text {
+		defaults["tokenization"] = "word"
+	}
+	return defaults
+}
+
+// DiffClusters compares the schemas of two clusters
+func DiffClusters(ctx context.Context, a, b *API) (*SchemaDiff, error) {
+	dumpA, err := a.Getter().Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get schema A: %w", err)
+	}
+	dumpB, err := b.Getter().Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get schema B: %w", err)
+	}
+	return Diff(dumpA, dumpB)
+}
+
+// Diff compares two schemas. Settings which one schema leaves unset and the other sets
+// to the default of the server are the same, as are unset and empty values.
+func Diff(a, b *Dump) (*SchemaDiff, error) {
+	classesA, err := classMaps(a)
+	if err != nil {
+		return nil, fmt.Errorf("schema A: %w", err)
+	}
+	classesB, err := classMaps(b)
+	if err != nil {
+		return nil, fmt.Errorf("schema B: %w", err)
+	}
+	diff := &SchemaDiff{}
+	for _, name := range slices.Sorted(maps.Keys(classesA)) {
+		classB, ok := classesB[name]
+		if !ok {
+			diff.OnlyInA = append(diff.OnlyInA, name)
+			continue
+		}
+		if differences := compareClassMaps(classesA[name], classB, false); len(differences) > 0 {
+			diff.Classes = append(diff.Classes, ClassDiff{Class: name, Differences: differences})
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(classesB)) {
+		if _, ok := classesA[name]; !ok {
+			diff.OnlyInB = append(diff.OnlyInB, name)
+		}
+	}
+	return diff, nil
+}
+
+// classMaps returns the classes of a schema as JSON maps by name
+func classMaps(dump *Dump) (map[string]map[string]any, error) {
+	classes := map[string]map[string]any{}
+	if dump == nil {
+		return classes, nil
+	}
+	for _, class := range dump.Classes {
+		m, err := toMap(class)
+		if err != nil {
+			return nil, err
+		}
+		classes[class.Class] = m
+	}
+	return classes, nil
+}
+
+// compareClasses compares two classes, see compareClassMaps
+func compareClasses(a, b *models.Class, onlySetInA bool) ([]Difference, error) {
+	mapA, err := toMap(a)
+	if err != nil {
+		return nil, err
+	}
+	mapB, err := toMap(b)
+	if err != nil {
+		return nil, err
+	}
+	return compareClassMaps(mapA, mapB, onlySetInA), nil
+}
+
+// compareClassMaps compares two classes as JSON maps, with unset settings being the
+// defaults of the server. It is the comparison behind Diff, CompareClasses and
+// PlanMigration. With onlySetInA, settings which A leaves unset are not compared,
+// while properties and named vectors of either class still are.
+func compareClassMaps(a, b map[string]any, onlySetInA bool) []Difference {
+	c := &comparison{onlySetInA: onlySetInA}
+	c.maps("", a, b, classDefaults)
+	return c.differences
+}
+
+type comparison struct {
+	onlySetInA  bool
+	differences []Difference
+}
+
+func (c *comparison) add(path string, a, b any) {
+	c.differences = append(c.differences, Difference{Section: section(path), Path: path, A: a, B: b})
+}
+
+func (c *comparison) maps(path string, a, b map[string]any, defaults map[string]any) {
+	keys := slices.Sorted(maps.Keys(a))
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		keyPath := strings.TrimPrefix(path+"."+key, ".")
+		keyDefault := defaultOf(defaults, key)
+		if keyDefault == ignored {
+			continue
+		}
+		switch key {
+		case "properties", "nestedProperties":
+			c.properties(keyPath, a[key], b[key])
+			continue
+		case "vectorConfig":
+			if path == "" {
+				c.vectorConfigs(a[key], b[key])
+				continue
+			}
+		case "vectorizer":
+			if strings.HasPrefix(path, "vectorConfig.") {
+				c.vectorizers(keyPath, a[key], b[key])
+				continue
+			}
+		}
+		va, vb := a[key], b[key]
+		if c.onlySetInA && va == nil {
+			continue
+		}
+		subDefaults, _ := keyDefault.(map[string]any)
+		mapA, isMapA := va.(map[string]any)
+		mapB, isMapB := vb.(map[string]any)
+		if (isMapA || va == nil) && (isMapB || vb == nil) && (isMapA || isMapB) {
+			c.maps(keyPath, mapA, mapB, subDefaults)
+			continue
+		}
+		if va == nil {
+			va = keyDefault
+		}
+		if vb == nil {
+			vb = keyDefault
+		}
+		if !sameValue(va, vb) {
+			c.add(keyPath, a[key], b[key])
+		}
+	}
+}
+
+// properties compares lists of properties by name
+func (c *comparison) properties(path string, a, b any) {
+	propsA, propsB := byName(a), byName(b)
+	names := slices.Sorted(maps.Keys(propsA))
+	for name := range propsB {
+		if _, ok := propsA[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		propertyPath := path + "." + name
+		pa, okA := propsA[name]
+		pb, okB := propsB[name]
+		if !okA || !okB {
+			c.add(propertyPath, nilIfMissing(pa, okA), nilIfMissing(pb, okB))
+			continue
+		}
+		dataType := pa["dataType"]
+		if dataType == nil {
+			dataType = pb["dataType"]
+		}
+		c.maps(propertyPath, pa, pb, propertyDefaults(dataType))
+	}
+}
+
+// vectorConfigs compares named vectors by name
+func (c *comparison) vectorConfigs(a, b any) {
+	configsA, _ := a.(map[string]any)
+	configsB, _ := b.(map[string]any)
+	names := slices.Sorted(maps.Keys(configsA))
+	for name := range configsB {
+		if _, ok := configsA[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		path := "vectorConfig." + name
+		ca, okA := configsA[name].(map[string]any)
+		cb, okB := configsB[name].(map[string]any)
+		if !okA || !okB {
+			c.add(path, configsA[name], configsB[name])
+			continue
+		}
+		c.maps(path, ca, cb, vectorConfigDefaults)
+	}
+}
+
+// vectorizers compares the vectorizer of named vectors, a map of the module to its
+// config, by the module and then by its config
+func (c *comparison) vectorizers(path string, a, b any) {
+	moduleA, moduleB := vectorizerModule(a), vectorizerModule(b)
+	if c.onlySetInA && moduleA == "" {
+		return
+	}
+	if moduleA != moduleB {
+		c.add(path, moduleA, moduleB)
+		return
+	}
+	vectorizerA, _ := a.(map[string]any)
+	vectorizerB, _ := b.(map[string]any)
+	configA, _ := vectorizerA[moduleA].(map[string]any)
+	configB, _ := vectorizerB[moduleB].(map[string]any)
+	c.maps(path+"."+moduleA, configA, configB, nil)
+}
+
+// defaultOf returns the default of a setting under key
+func defaultOf(defaults map[string]any, key string) any {
+	if value, ok := defaults[key]; ok {
+		return value
+	}
+	return defaults[anyKey]
+}
+
+func byName(properties any) map[string]map[string]any {
+	list, _ := properties.([]any)
+	m := make(map[string]map[string]any, len(list))
+	for _, item := range list {
+		if property, ok := item.(map[string]any); ok {
+			name, _ := property["name"].(string)
+			m[name] = property
+		}
+	}
+	return m
+}
+
+func nilIfMissing(property map[string]any, ok bool) any {
+	if !ok {
+		return nil
+	}
+	return property
+}
+
+// section returns the section of the class a setting belongs to
+func section(path string) string {
+	first, _, _ := strings.Cut(path, ".")
+	switch first {
+	case "properties", "vectorConfig", "vectorIndexConfig", "shardingConfig", "replicationConfig",
+		"invertedIndexConfig", "multiTenancyConfig", "moduleConfig":
+		return first
+	default:
+		return "class"
+	}
+}
+
+// sameValue compares JSON values, with unset, false, zero and empty values being the same
+func sameValue(a, b any) bool {
+	if isUnset(a) && isUnset(b) {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func isUnset(v any) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !value
+	case string:
+		return value == ""
+	case float64:
+		return value == 0
+	case []any:
+		return len(value) == 0
+	case map[string]any:
+		return len(value) == 0
+	default:
+		return false
+	}
+}
+
+func toMap(class *models.Class) (map[string]any, error) {
+	data, err := json.Marshal(class)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	err = json.Unmarshal(data, &m)
+	return m, err
+}
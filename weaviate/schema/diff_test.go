@@ -0,0 +1,220 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// serverDump is a schema as returned by the server, with all defaults set
+const serverDump = `{"classes": [{
+	"class": "Article",
+	"vectorizer": "none",
+	"vectorIndexType": "hnsw",
+	"vectorIndexConfig": {"distance": "cosine", "ef": -1, "efConstruction": 128, "skip": false},
+	"invertedIndexConfig": {"bm25": {"b": 0.75, "k1": 1.2}, "cleanupIntervalSeconds": 60,
+		"stopwords": {"preset": "en", "additions": null, "removals": null}},
+	"replicationConfig": {"factor": 1, "asyncEnabled": false, "deletionStrategy": "NoAutomatedResolution"},
+	"shardingConfig": {"actualCount": 3, "actualVirtualCount": 384, "desiredCount": 3, "desiredVirtualCount": 128,
+		"function": "murmur3", "key": "_id", "strategy": "hash", "virtualPerPhysical": 128},
+	"multiTenancyConfig": {"enabled": false, "autoTenantCreation": false},
+	"properties": [
+		{"name": "title", "dataType": ["text"], "tokenization": "word", "indexFilterable": true, "indexSearchable": true},
+		{"name": "wordCount", "dataType": ["int"], "indexFilterable": true, "indexSearchable": false, "indexRangeFilters": false},
+		{"name": "address", "dataType": ["object"], "indexFilterable": true, "nestedProperties": [
+			{"name": "city", "dataType": ["text"], "tokenization": "word", "indexFilterable": true, "indexSearchable": true}
+		]}
+	],
+	"vectorConfig": {"summary": {"vectorizer": {"none": {}}, "vectorIndexType": "hnsw", "vectorIndexConfig": {"distance": "cosine"}}}
+}, {"class": "Author", "properties": []}]}`
+
+func TestDiff(t *testing.T) {
+	var a Dump
+	require.NoError(t, json.Unmarshal([]byte(serverDump), &a))
+
+	t.Run("server defaults are no differences", func(t *testing.T) {
+		b := Dump{Schema: models.Schema{Classes: []*models.Class{
+			{
+				Class: "Article",
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}},
+					{Name: "wordCount", DataType: []string{"int"}},
+					{Name: "address", DataType: []string{"object"}, NestedProperties: []*models.NestedProperty{
+						{Name: "city", DataType: []string{"text"}},
+					}},
+				},
+				VectorConfig: map[string]models.VectorConfig{
+					"summary": {Vectorizer: map[string]any{"none": map[string]any{}}},
+				},
+			},
+			{Class: "Author"},
+		}}}
+		diff, err := Diff(&a, &b)
+		require.NoError(t, err)
+		assert.True(t, diff.Empty(), "%+v", diff)
+	})
+
+	t.Run("differences", func(t *testing.T) {
+		no := false
+		b := Dump{Schema: models.Schema{Classes: []*models.Class{
+			{
+				Class:               "Article",
+				InvertedIndexConfig: &models.InvertedIndexConfig{Bm25: &models.BM25Config{B: 0.5, K1: 1.2}},
+				ReplicationConfig:   &models.ReplicationConfig{Factor: 3},
+				MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+				ShardingConfig:      map[string]any{"actualCount": 1, "virtualPerPhysical": 64},
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}, Tokenization: "field"},
+					{Name: "wordCount", DataType: []string{"number"}, IndexFilterable: &no},
+					{Name: "address", DataType: []string{"object"}, NestedProperties: []*models.NestedProperty{
+						{Name: "zip", DataType: []string{"text"}},
+					}},
+				},
+				VectorConfig: map[string]models.VectorConfig{
+					"summary": {
+						Vectorizer:        map[string]any{"none": map[string]any{}},
+						VectorIndexConfig: map[string]any{"distance": "dot"},
+					},
+					"title": {Vectorizer: map[string]any{"none": map[string]any{}}},
+				},
+			},
+			{Class: "Publisher"},
+		}}}
+		diff, err := Diff(&a, &b)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Author"}, diff.OnlyInA)
+		assert.Equal(t, []string{"Publisher"}, diff.OnlyInB)
+		require.Len(t, diff.Classes, 1)
+		assert.Equal(t, "Article", diff.Classes[0].Class)
+
+		var paths []string
+		sections := map[string]string{}
+		for _, d := range diff.Classes[0].Differences {
+			paths = append(paths, d.Path)
+			sections[d.Path] = d.Section
+		}
+		assert.Equal(t, []string{
+			"invertedIndexConfig.bm25.b",
+			"multiTenancyConfig.enabled",
+			"properties.address.nestedProperties.city",
+			"properties.address.nestedProperties.zip",
+			"properties.title.tokenization",
+			"properties.wordCount.dataType",
+			"properties.wordCount.indexFilterable",
+			"replicationConfig.factor",
+			"shardingConfig.virtualPerPhysical",
+			"vectorConfig.summary.vectorIndexConfig.distance",
+			"vectorConfig.title",
+		}, paths)
+		assert.Equal(t, "properties", sections["properties.address.nestedProperties.zip"])
+		assert.Equal(t, "shardingConfig", sections["shardingConfig.virtualPerPhysical"])
+		assert.Equal(t, "vectorConfig", sections["vectorConfig.title"])
+	})
+}
+
+// serverClass is a class as a v1.37 server returns it for a class which only sets
+// its name, vectorizer and properties
+const serverClass = `{
+	"class": "Article",
+	"vectorizer": "text2vec-openai",
+	"moduleConfig": {"text2vec-openai": {"vectorizeClassName": true}},
+	"vectorIndexType": "hnsw",
+	"vectorIndexConfig": {
+		"bq": {"enabled": false},
+		"cleanupIntervalSeconds": 300,
+		"distance": "cosine",
+		"dynamicEfFactor": 8, "dynamicEfMax": 500, "dynamicEfMin": 100,
+		"ef": -1, "efConstruction": 128,
+		"filterStrategy": "acorn",
+		"flatSearchCutoff": 40000,
+		"maxConnections": 32,
+		"multivector": {"aggregation": "maxSim", "enabled": false,
+			"muvera": {"dprojections": 16, "enabled": false, "ksim": 4, "repetitions": 10}},
+		"pq": {"bitCompression": false, "centroids": 256, "enabled": false,
+			"encoder": {"distribution": "log-normal", "type": "kmeans"}, "segments": 0, "trainingLimit": 100000},
+		"rq": {"bits": 8, "enabled": false, "rescoreLimit": 20},
+		"skip": false,
+		"skipDefaultQuantization": false,
+		"sq": {"enabled": false, "rescoreLimit": 20, "trainingLimit": 100000},
+		"trackDefaultQuantization": true,
+		"vectorCacheMaxObjects": 1000000000000
+	},
+	"invertedIndexConfig": {
+		"bm25": {"b": 0.75, "k1": 1.2},
+		"cleanupIntervalSeconds": 60,
+		"stopwords": {"additions": null, "preset": "en", "removals": null},
+		"usingBlockMaxWAND": true
+	},
+	"replicationConfig": {"asyncEnabled": false, "deletionStrategy": "NoAutomatedResolution", "factor": 1},
+	"shardingConfig": {"actualCount": 1, "actualVirtualCount": 128, "desiredCount": 1, "desiredVirtualCount": 128,
+		"function": "murmur3", "key": "_id", "strategy": "hash", "virtualPerPhysical": 128},
+	"multiTenancyConfig": {"autoTenantActivation": false, "autoTenantCreation": false, "enabled": false},
+	"properties": [{
+		"name": "title", "dataType": ["text"], "tokenization": "word",
+		"indexFilterable": true, "indexRangeFilters": false, "indexSearchable": true,
+		"moduleConfig": {"text2vec-openai": {"skip": false, "vectorizePropertyName": false}}
+	}]
+}`
+
+func TestDiff_ServerDefaults(t *testing.T) {
+	var server models.Class
+	require.NoError(t, json.Unmarshal([]byte(serverClass), &server))
+	minimal := &models.Class{
+		Class:      "Article",
+		Vectorizer: "text2vec-openai",
+		Properties: []*models.Property{{Name: "title", DataType: []string{"text"}}},
+	}
+
+	diff, err := Diff(&Dump{Schema: models.Schema{Classes: []*models.Class{minimal}}},
+		&Dump{Schema: models.Schema{Classes: []*models.Class{&server}}})
+	require.NoError(t, err)
+	assert.True(t, diff.Empty(), "%+v", diff)
+
+	drifts, err := CompareClasses(minimal, &server)
+	require.NoError(t, err)
+	assert.Empty(t, drifts)
+
+	t.Run("changed defaults", func(t *testing.T) {
+		var changed models.Class
+		require.NoError(t, json.Unmarshal([]byte(serverClass), &changed))
+		changed.VectorIndexConfig.(map[string]any)["pq"].(map[string]any)["enabled"] = true
+		changed.VectorIndexConfig.(map[string]any)["maxConnections"] = 64
+		changed.InvertedIndexConfig.Bm25.B = 0.5
+		diff, err := Diff(&Dump{Schema: models.Schema{Classes: []*models.Class{minimal}}},
+			&Dump{Schema: models.Schema{Classes: []*models.Class{&changed}}})
+		require.NoError(t, err)
+		require.Len(t, diff.Classes, 1)
+		var paths []string
+		for _, d := range diff.Classes[0].Differences {
+			paths = append(paths, d.Path)
+		}
+		assert.Equal(t, []string{
+			"invertedIndexConfig.bm25.b",
+			"vectorIndexConfig.maxConnections",
+			"vectorIndexConfig.pq.enabled",
+		}, paths)
+	})
+}
+
+func TestDiffClusters(t *testing.T) {
+	newAPI := func(dump string) *API {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(dump))
+		}))
+		t.Cleanup(server.Close)
+		return New(connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil), nil)
+	}
+	diff, err := DiffClusters(context.Background(), newAPI(serverDump), newAPI(`{"classes": [{"class": "Author"}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Article"}, diff.OnlyInA)
+	assert.Empty(t, diff.Classes)
+}
@@ -0,0 +1,149 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/fault"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// DriftKind tells how a class drifts from its expected definition
+type DriftKind string
+
+const (
+	// DriftMissing is expected but not part of the class
+	DriftMissing DriftKind = "missing"
+	// DriftUnexpected is part of the class but not expected
+	DriftUnexpected DriftKind = "unexpected"
+	// DriftChanged differs from the expected value
+	DriftChanged DriftKind = "changed"
+)
+
+// Drift is a difference between the expected and the actual definition of a class
+type Drift struct {
+	Kind DriftKind
+	// Path of the setting, e.g. "properties.title.tokenization"
+	Path     string
+	Expected interface{}
+	Actual   interface{}
+}
+
+func (d Drift) String() string {
+	switch d.Kind {
+	case DriftMissing:
+		return fmt.Sprintf("%s: missing", d.Path)
+	case DriftUnexpected:
+		return fmt.Sprintf("%s: unexpected", d.Path)
+	default:
+		return fmt.Sprintf("%s: expected %v, got %v", d.Path, d.Expected, d.Actual)
+	}
+}
+
+// DriftChecker builder to compare a class of the schema with its expected definition,
+// such as a class derived from a struct with typed.Class
+type DriftChecker struct {
+	connection *connection.Connection
+	class      *models.Class
+}
+
+// WithClass sets the expected definition of the class
+func (c *DriftChecker) WithClass(class *models.Class) *DriftChecker {
+	c.class = class
+	return c
+}
+
+// Do get the class from the schema and compare it with the expected definition.
+// A class which does not exist is reported as missing.
+func (c *DriftChecker) Do(ctx context.Context) ([]Drift, error) {
+	ctx, op := c.connection.Telemetry().Start(ctx, "schema.DriftChecker",
+		telemetry.Collection(classNameOf(c.class)))
+	result, err := c.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (c *DriftChecker) do(ctx context.Context) ([]Drift, error) {
+	if c.class == nil {
+		return nil, errors.New("drift checker: no class set")
+	}
+	actual, err := (&ClassGetter{connection: c.connection}).WithClassName(c.class.Class).Do(ctx)
+	var clientErr *fault.WeaviateClientError
+	if errors.As(err, &clientErr) && clientErr.StatusCode == http.StatusNotFound {
+		return []Drift{{Kind: DriftMissing, Path: c.class.Class}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return CompareClasses(c.class, actual)
+}
+
+// CompareClasses returns how actual drifts from expected. Settings which expected leaves
+// empty, such as tokenization or index flags, are defaulted by the server and not compared.
+// Settings which actual leaves empty are compared as the defaults of the server.
+func CompareClasses(expected, actual *models.Class) ([]Drift, error) {
+	differences, err := compareClasses(expected, actual, true)
+	if err != nil {
+		return nil, err
+	}
+	drifts := make([]Drift, len(differences))
+	for i, d := range differences {
+		drifts[i] = toDrift(d)
+	}
+	return drifts, nil
+}
+
+// toDrift converts a difference between an expected class A and an actual class B. The
+// paths of nested properties leave out "nestedProperties", e.g. "properties.address.city".
+func toDrift(d Difference) Drift {
+	drift := Drift{Kind: DriftChanged, Path: strings.ReplaceAll(d.Path, ".nestedProperties.", ".")}
+	switch {
+	case isEntry(d.Path) && d.B == nil:
+		drift.Kind = DriftMissing
+	case isEntry(d.Path) && d.A == nil:
+		drift.Kind = DriftUnexpected
+	default:
+		drift.Expected, drift.Actual = driftValue(d.A), driftValue(d.B)
+	}
+	return drift
+}
+
+// isEntry reports whether path is a property or a named vector, rather than a setting
+func isEntry(path string) bool {
+	segments := strings.Split(path, ".")
+	n := len(segments)
+	return n >= 2 && (segments[n-2] == "properties" || segments[n-2] == "nestedProperties") ||
+		n == 2 && segments[0] == "vectorConfig"
+}
+
+// driftValue returns lists of strings, such as data types, as []string like models do
+func driftValue(value interface{}) interface{} {
+	list, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+	values := make([]string, len(list))
+	for i, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return value
+		}
+		values[i] = s
+	}
+	return values
+}
+
+// vectorizerModule returns the module of a named vector's vectorizer, the only key of its config
+func vectorizerModule(vectorizer interface{}) string {
+	if config, ok := vectorizer.(map[string]interface{}); ok {
+		for module := range config {
+			return module
+		}
+	}
+	return ""
+}
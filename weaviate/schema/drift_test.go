@@ -0,0 +1,77 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestDriftChecker(t *testing.T) {
+	yes, no := true, false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/schema/Article" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		json.NewEncoder(w).Encode(models.Class{
+			Class:      "Article",
+			Vectorizer: "none",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}, Tokenization: "word", IndexFilterable: &yes, IndexSearchable: &yes},
+				{Name: "wordCount", DataType: []string{"number"}, IndexFilterable: &yes},
+				{Name: "address", DataType: []string{"object"}, NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: []string{"text"}, Tokenization: "word"},
+				}},
+				{Name: "legacy", DataType: []string{"text"}},
+			},
+			VectorConfig: map[string]models.VectorConfig{
+				"summary": {Vectorizer: map[string]interface{}{"text2vec-openai": map[string]interface{}{}}, VectorIndexType: "hnsw"},
+			},
+		})
+	}))
+	defer server.Close()
+	con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+	api := New(con, nil)
+
+	drifts, err := api.DriftChecker().WithClass(&models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}, IndexSearchable: &no},
+			{Name: "wordCount", DataType: []string{"int"}},
+			{Name: "address", DataType: []string{"object"}, NestedProperties: []*models.NestedProperty{
+				{Name: "city", DataType: []string{"text"}, Tokenization: "field"},
+				{Name: "zip", DataType: []string{"text"}},
+			}},
+		},
+		VectorConfig: map[string]models.VectorConfig{
+			"summary": {Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+			"title":   {Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+		},
+	}).Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Drift{
+		{Kind: DriftChanged, Path: "properties.address.city.tokenization", Expected: "field", Actual: "word"},
+		{Kind: DriftMissing, Path: "properties.address.zip"},
+		{Kind: DriftUnexpected, Path: "properties.legacy"},
+		{Kind: DriftChanged, Path: "properties.title.indexSearchable", Expected: false, Actual: true},
+		{Kind: DriftChanged, Path: "properties.wordCount.dataType", Expected: []string{"int"}, Actual: []string{"number"}},
+		{Kind: DriftChanged, Path: "vectorConfig.summary.vectorizer", Expected: "none", Actual: "text2vec-openai"},
+		{Kind: DriftMissing, Path: "vectorConfig.title"},
+	}, drifts)
+	assert.Equal(t, "properties.wordCount.dataType: expected [int], got [number]", drifts[4].String())
+
+	t.Run("missing class", func(t *testing.T) {
+		drifts, err := api.DriftChecker().WithClass(&models.Class{Class: "Author"}).Do(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, []Drift{{Kind: DriftMissing, Path: "Author"}}, drifts)
+	})
+}
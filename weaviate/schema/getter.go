@@ -21,6 +21,13 @@ type Getter struct {
 
 // Do get and return the weaviate schema
 func (sg *Getter) Do(ctx context.Context) (*Dump, error) {
+	ctx, op := sg.connection.Telemetry().Start(ctx, "schema.Getter")
+	result, err := sg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (sg *Getter) do(ctx context.Context) (*Dump, error) {
 	responseData, err := sg.connection.RunREST(ctx, "/schema", http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
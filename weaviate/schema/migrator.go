@@ -0,0 +1,362 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// MigrationAction is a change a migration step applies to the schema
+type MigrationAction string
+
+const (
+	// ActionCreateClass creates a class without its reference properties, which are
+	// added once all classes exist
+	ActionCreateClass MigrationAction = "createClass"
+	// ActionAddProperty adds a property to a class
+	ActionAddProperty MigrationAction = "addProperty"
+	// ActionAddVectors adds named vectors to a class
+	ActionAddVectors MigrationAction = "addVectors"
+	// ActionUpdateClass updates the mutable settings of a class
+	ActionUpdateClass MigrationAction = "updateClass"
+	// ActionDeleteIndex deletes an inverted index of a property
+	ActionDeleteIndex MigrationAction = "deleteIndex"
+)
+
+// MigrationStep is one change of a MigrationPlan
+type MigrationStep struct {
+	Action MigrationAction
+	Class  string
+	// Definition is the class to create, or the desired class whose mutable settings are updated
+	Definition *models.Class
+	// Property to add
+	Property *models.Property
+	// Vectors to add
+	Vectors map[string]models.VectorConfig
+	// Changes are the paths of the updated settings, e.g. "invertedIndexConfig.bm25.b"
+	Changes []string
+	// PropertyName and Index name the index to delete: filterable, searchable or rangeFilters
+	PropertyName, Index string
+}
+
+func (s MigrationStep) String() string {
+	switch s.Action {
+	case ActionCreateClass:
+		return fmt.Sprintf("create class %s", s.Class)
+	case ActionAddProperty:
+		return fmt.Sprintf("add property %s.%s", s.Class, s.Property.Name)
+	case ActionAddVectors:
+		return fmt.Sprintf("add vectors %s to %s", strings.Join(slices.Sorted(maps.Keys(s.Vectors)), ", "), s.Class)
+	case ActionUpdateClass:
+		return fmt.Sprintf("update %s of %s", strings.Join(s.Changes, ", "), s.Class)
+	case ActionDeleteIndex:
+		return fmt.Sprintf("delete %s index of %s.%s", s.Index, s.Class, s.PropertyName)
+	default:
+		return string(s.Action)
+	}
+}
+
+// MigrationConflict is a change Weaviate cannot apply in place, such as a changed data type
+// or a removed property. The class has to be recreated to apply it.
+type MigrationConflict struct {
+	Class string
+	Drift
+}
+
+func (c MigrationConflict) String() string {
+	return fmt.Sprintf("%s.%s", c.Class, c.Drift)
+}
+
+// MigrationPlan is the ordered list of steps which migrate the schema to the desired classes
+type MigrationPlan struct {
+	Steps     []MigrationStep
+	Conflicts []MigrationConflict
+	// Applied is the number of steps which have been applied
+	Applied int
+}
+
+// Migrator builder to migrate the schema to a desired set of classes. Classes which are
+// not part of the desired set are left untouched.
+type Migrator struct {
+	api             *API
+	classes         []*models.Class
+	dryRun          bool
+	ignoreConflicts bool
+}
+
+// WithClasses sets the desired classes
+func (m *Migrator) WithClasses(classes ...*models.Class) *Migrator {
+	m.classes = classes
+	return m
+}
+
+// WithDryRun only plans the migration, no step is applied
+func (m *Migrator) WithDryRun() *Migrator {
+	m.dryRun = true
+	return m
+}
+
+// WithIgnoreConflicts applies the plan even if it has conflicts, which are left as they are
+func (m *Migrator) WithIgnoreConflicts() *Migrator {
+	m.ignoreConflicts = true
+	return m
+}
+
+// Do plan the migration by comparing the desired classes with the schema and apply
+// it, unless it is a dry run. Steps are applied in order: new classes, new properties,
+// new named vectors, mutable config updates and index deletions. A plan with
+// conflicts is not applied, unless they are ignored.
+func (m *Migrator) Do(ctx context.Context) (*MigrationPlan, error) {
+	ctx, op := m.api.connection.Telemetry().Start(ctx, "schema.Migrator")
+	result, err := m.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (m *Migrator) do(ctx context.Context) (*MigrationPlan, error) {
+	dump, err := m.api.Getter().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := PlanMigration(m.classes, dump)
+	if err != nil {
+		return nil, err
+	}
+	if m.dryRun {
+		return plan, nil
+	}
+	if len(plan.Conflicts) > 0 && !m.ignoreConflicts {
+		return plan, fmt.Errorf("migration has %d changes which cannot be applied in place, first: %s",
+			len(plan.Conflicts), plan.Conflicts[0])
+	}
+	for _, step := range plan.Steps {
+		if err := m.apply(ctx, step); err != nil {
+			return plan, fmt.Errorf("migration step %q: %w", step, err)
+		}
+		plan.Applied++
+	}
+	return plan, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, step MigrationStep) error {
+	switch step.Action {
+	case ActionCreateClass:
+		return m.api.ClassCreator().WithClass(step.Definition).Do(ctx)
+	case ActionAddProperty:
+		return m.api.PropertyCreator().WithClassName(step.Class).WithProperty(step.Property).Do(ctx)
+	case ActionAddVectors:
+		return m.api.VectorAdder().WithClassName(step.Class).WithVectors(step.Vectors).Do(ctx)
+	case ActionUpdateClass:
+		// update the current class, which holds the properties and vectors added before
+		current, err := m.api.ClassGetter().WithClassName(step.Class).Do(ctx)
+		if err != nil {
+			return err
+		}
+		updated, err := withChanges(current, step.Definition, step.Changes)
+		if err != nil {
+			return err
+		}
+		return m.api.ClassUpdater().WithClass(updated).Do(ctx)
+	case ActionDeleteIndex:
+		deleter := m.api.PropertyIndexDeleter().WithClassName(step.Class).WithPropertyName(step.PropertyName)
+		switch step.Index {
+		case "filterable":
+			deleter.WithFilterable()
+		case "searchable":
+			deleter.WithSearchable()
+		default:
+			deleter.WithRangeFilters()
+		}
+		return deleter.Do(ctx)
+	default:
+		return fmt.Errorf("unknown action %q", step.Action)
+	}
+}
+
+// PlanMigration returns the steps which migrate the schema dump to the desired classes
+func PlanMigration(desired []*models.Class, dump *Dump) (*MigrationPlan, error) {
+	existing := map[string]*models.Class{}
+	if dump != nil {
+		for _, class := range dump.Classes {
+			existing[className(class.Class)] = class
+		}
+	}
+	plan := &MigrationPlan{}
+	var creates, properties, vectors, updates, deletions []MigrationStep
+	for _, class := range desired {
+		name := className(class.Class)
+		actual, ok := existing[name]
+		if !ok {
+			create, refs := withoutReferences(class)
+			creates = append(creates, MigrationStep{Action: ActionCreateClass, Class: name, Definition: create})
+			for _, ref := range refs {
+				properties = append(properties, MigrationStep{Action: ActionAddProperty, Class: name, Property: ref})
+			}
+			continue
+		}
+
+		expected := *class
+		expected.Class = name
+		drifts, err := CompareClasses(&expected, actual)
+		if err != nil {
+			return nil, fmt.Errorf("plan migration of %s: %w", name, err)
+		}
+		addedVectors := map[string]models.VectorConfig{}
+		var changes []string
+		for _, drift := range drifts {
+			path := strings.Split(drift.Path, ".")
+			switch {
+			case drift.Kind == DriftChanged && isMutable(drift.Path):
+				changes = append(changes, drift.Path)
+			case path[0] == "properties" && len(path) == 2 && drift.Kind == DriftMissing:
+				properties = append(properties, MigrationStep{
+					Action: ActionAddProperty, Class: name, Property: findProperty(class, path[1]),
+				})
+			case path[0] == "properties" && len(path) == 3 && drift.Kind == DriftChanged && drift.Expected == false &&
+				strings.HasPrefix(path[2], "index"):
+				deletions = append(deletions, MigrationStep{
+					Action: ActionDeleteIndex, Class: name, PropertyName: path[1], Index: indexName(path[2]),
+				})
+			case path[0] == "vectorConfig" && len(path) == 2 && drift.Kind == DriftMissing && len(actual.VectorConfig) > 0:
+				addedVectors[path[1]] = class.VectorConfig[path[1]]
+			default:
+				plan.Conflicts = append(plan.Conflicts, MigrationConflict{Class: name, Drift: drift})
+			}
+		}
+		if len(addedVectors) > 0 {
+			vectors = append(vectors, MigrationStep{Action: ActionAddVectors, Class: name, Vectors: addedVectors})
+		}
+		if len(changes) > 0 {
+			updates = append(updates, MigrationStep{Action: ActionUpdateClass, Class: name, Definition: &expected, Changes: changes})
+		}
+	}
+	plan.Steps = slices.Concat(creates, properties, vectors, updates, deletions)
+	return plan, nil
+}
+
+// className returns the name of a class as Weaviate stores it, with an upper case first letter
+func className(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
+
+// withoutReferences returns a copy of the class without its reference properties, and them
+func withoutReferences(class *models.Class) (*models.Class, []*models.Property) {
+	create := *class
+	create.Class = className(class.Class)
+	create.Properties = nil
+	var refs []*models.Property
+	for _, p := range class.Properties {
+		if isReference(p) {
+			refs = append(refs, p)
+		} else {
+			create.Properties = append(create.Properties, p)
+		}
+	}
+	return &create, refs
+}
+
+// isReference reports whether the data type of a property is a class name
+func isReference(p *models.Property) bool {
+	for _, dataType := range p.DataType {
+		if r, _ := utf8.DecodeRuneInString(dataType); unicode.IsUpper(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func findProperty(class *models.Class, name string) *models.Property {
+	for _, p := range class.Properties {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// indexName returns the index name PropertyIndexDeleter uses for a property setting
+func indexName(setting string) string {
+	switch setting {
+	case "indexFilterable":
+		return "filterable"
+	case "indexSearchable":
+		return "searchable"
+	default:
+		return "rangeFilters"
+	}
+}
+
+// immutableSettings cannot be changed once a class exists
+var immutableSettings = map[string]bool{
+	"invertedIndexConfig.indexTimestamps":     true,
+	"invertedIndexConfig.indexNullState":      true,
+	"invertedIndexConfig.indexPropertyLength": true,
+	"vectorIndexConfig.distance":              true,
+	"multiTenancyConfig.enabled":              true,
+}
+
+// mutableSections hold the settings which can be updated once a class exists, unless immutable
+var mutableSections = []string{"invertedIndexConfig", "replicationConfig", "vectorIndexConfig", "multiTenancyConfig"}
+
+func isImmutable(path string) bool {
+	return immutableSettings[path] || strings.HasPrefix(path, "shardingConfig.") ||
+		strings.HasSuffix(path, ".vectorIndexConfig.distance")
+}
+
+// isMutable reports whether the setting at path can be updated with ClassUpdater
+func isMutable(path string) bool {
+	if path == "description" {
+		return true
+	}
+	segments := strings.Split(path, ".")
+	mutable := len(segments) > 1 && slices.Contains(mutableSections, segments[0]) ||
+		len(segments) > 3 && segments[0] == "vectorConfig" && segments[2] == "vectorIndexConfig"
+	return mutable && !isImmutable(path)
+}
+
+// withChanges returns class with the settings at the paths of changes set to those of desired
+func withChanges(class, desired *models.Class, changes []string) (*models.Class, error) {
+	want, err := toMap(desired)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := toMap(class)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range changes {
+		keys := strings.Split(change, ".")
+		value, have := want, updated
+		for _, key := range keys[:len(keys)-1] {
+			value, _ = value[key].(map[string]interface{})
+			next, ok := have[key].(map[string]interface{})
+			if !ok {
+				next = map[string]interface{}{}
+				have[key] = next
+			}
+			have = next
+		}
+		have[keys[len(keys)-1]] = value[keys[len(keys)-1]]
+	}
+	var result models.Class
+	if err := fromMap(updated, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func fromMap(m map[string]interface{}, class *models.Class) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, class)
+}
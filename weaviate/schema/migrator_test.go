@@ -0,0 +1,197 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	"github.com/weaviate/weaviate/entities/models"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func existingArticle() *models.Class {
+	yes := true
+	return &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"text"}, IndexFilterable: &yes, IndexSearchable: &yes},
+			{Name: "wordCount", DataType: []string{"int"}},
+		},
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Bm25:            &models.BM25Config{B: 0.75, K1: 1.2},
+			IndexTimestamps: false,
+		},
+		VectorConfig: map[string]models.VectorConfig{
+			"title": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: map[string]interface{}{"distance": "cosine", "ef": -1},
+			},
+		},
+	}
+}
+
+func desiredClasses() []*models.Class {
+	no := false
+	return []*models.Class{
+		{
+			Class:       "Author",
+			Description: "writes articles",
+			Properties: []*models.Property{
+				{Name: "name", DataType: []string{"text"}},
+				{Name: "wrote", DataType: []string{"Article"}},
+			},
+		},
+		{
+			Class:       "Article",
+			Description: "news",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"text"}, IndexSearchable: &no},
+				{Name: "wordCount", DataType: []string{"number"}},
+				{Name: "body", DataType: []string{"text"}},
+			},
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Bm25:            &models.BM25Config{B: 0.5, K1: 1.2},
+				IndexTimestamps: true,
+			},
+			VectorConfig: map[string]models.VectorConfig{
+				"title": {
+					Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+					VectorIndexConfig: map[string]interface{}{"ef": 64},
+				},
+				"body": {Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}},
+			},
+		},
+	}
+}
+
+func TestPlanMigration(t *testing.T) {
+	plan, err := PlanMigration(desiredClasses(), &Dump{Schema: models.Schema{Classes: []*models.Class{existingArticle()}}})
+	require.NoError(t, err)
+
+	var steps []string
+	for _, step := range plan.Steps {
+		steps = append(steps, step.String())
+	}
+	assert.Equal(t, []string{
+		"create class Author",
+		"add property Author.wrote",
+		"add property Article.body",
+		"add vectors body to Article",
+		"update description, invertedIndexConfig.bm25.b, vectorConfig.title.vectorIndexConfig.ef of Article",
+		"delete searchable index of Article.title",
+	}, steps)
+	assert.Equal(t, []*models.Property{{Name: "name", DataType: []string{"text"}}}, plan.Steps[0].Definition.Properties)
+
+	var conflicts []string
+	for _, conflict := range plan.Conflicts {
+		conflicts = append(conflicts, conflict.String())
+	}
+	assert.Equal(t, []string{
+		"Article.invertedIndexConfig.indexTimestamps: expected true, got <nil>",
+		"Article.properties.wordCount.dataType: expected [number], got [int]",
+	}, conflicts)
+}
+
+func TestMigrator(t *testing.T) {
+	var requests []string
+	var updated models.Class
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/schema":
+			json.NewEncoder(w).Encode(models.Schema{Classes: []*models.Class{existingArticle()}})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/schema/Article":
+			json.NewEncoder(w).Encode(existingArticle())
+		case r.Method == http.MethodPut:
+			json.NewDecoder(r.Body).Decode(&updated)
+		}
+	}))
+	defer server.Close()
+	con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil)
+	api := New(con, nil)
+
+	classes := desiredClasses()
+	// leave out the conflicting changes
+	classes[1].Properties[1].DataType = []string{"int"}
+	classes[1].InvertedIndexConfig.IndexTimestamps = false
+
+	t.Run("dry run", func(t *testing.T) {
+		requests = nil
+		plan, err := api.Migrator().WithClasses(classes...).WithDryRun().Do(context.Background())
+		require.NoError(t, err)
+		assert.Len(t, plan.Steps, 6)
+		assert.Zero(t, plan.Applied)
+		assert.Equal(t, []string{"GET /v1/schema"}, requests)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		requests = nil
+		plan, err := api.Migrator().WithClasses(classes...).Do(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 6, plan.Applied)
+		assert.Equal(t, []string{
+			"GET /v1/schema",
+			"POST /v1/schema",
+			"POST /v1/schema/Author/properties",
+			"POST /v1/schema/Article/properties",
+			"GET /v1/schema/Article",
+			"PUT /v1/schema/Article",
+			"GET /v1/schema/Article",
+			"PUT /v1/schema/Article",
+			"DELETE /v1/schema/Article/properties/title/index/searchable",
+		}, requests)
+		assert.Equal(t, "news", updated.Description)
+		assert.Equal(t, float32(0.5), updated.InvertedIndexConfig.Bm25.B)
+		assert.Equal(t, map[string]interface{}{"distance": "cosine", "ef": float64(64)}, updated.VectorConfig["title"].VectorIndexConfig)
+	})
+
+	t.Run("traces the builders", func(t *testing.T) {
+		recorder := tracetest.NewSpanRecorder()
+		tel, err := telemetry.New(&telemetry.Config{
+			TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		})
+		require.NoError(t, err)
+		con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil).
+			WithTelemetry(tel)
+
+		_, err = New(con, nil).Migrator().WithClasses(classes...).Do(context.Background())
+		require.NoError(t, err)
+
+		operations := map[string]sdktrace.ReadOnlySpan{}
+		for _, span := range recorder.Ended() {
+			if span.SpanKind() == trace.SpanKindInternal {
+				operations[span.Name()] = span
+			}
+		}
+		require.Contains(t, operations, "schema.Migrator")
+		migrator := operations["schema.Migrator"].SpanContext().SpanID()
+		for _, name := range []string{
+			"schema.Getter", "schema.ClassCreator", "schema.PropertyCreator",
+			"schema.ClassGetter", "schema.ClassUpdater", "schema.PropertyIndexDeleter",
+		} {
+			require.Contains(t, operations, name)
+			assert.Equal(t, migrator, operations[name].Parent().SpanID(), name)
+		}
+		assert.Contains(t, operations["schema.ClassCreator"].Attributes(), telemetry.Collection("Author"))
+		assert.Contains(t, operations["schema.PropertyIndexDeleter"].Attributes(), telemetry.Collection("Article"))
+	})
+
+	t.Run("conflicts are not applied", func(t *testing.T) {
+		requests = nil
+		plan, err := api.Migrator().WithClasses(desiredClasses()...).Do(context.Background())
+		assert.ErrorContains(t, err, "2 changes")
+		assert.Len(t, plan.Conflicts, 2)
+		assert.Equal(t, []string{"GET /v1/schema"}, requests)
+	})
+}
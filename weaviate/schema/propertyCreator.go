@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -31,6 +32,14 @@ func (pc *PropertyCreator) WithProperty(property *models.Property) *PropertyCrea
 
 // Do create the property on the class specified in the builder
 func (pc *PropertyCreator) Do(ctx context.Context) error {
+	ctx, op := pc.connection.Telemetry().Start(ctx, "schema.PropertyCreator",
+		telemetry.Collection(pc.className))
+	err := pc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (pc *PropertyCreator) do(ctx context.Context) error {
 	path := fmt.Sprintf("/schema/%v/properties", pc.className)
 	responseData, err := pc.connection.RunREST(ctx, path, http.MethodPost, pc.property)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // PropertyIndexDeleter is a builder to delete a property's index from a schema class
@@ -49,6 +50,14 @@ func (p *PropertyIndexDeleter) WithRangeFilters() *PropertyIndexDeleter {
 
 // Do deletes the property's index
 func (p *PropertyIndexDeleter) Do(ctx context.Context) error {
+	ctx, op := p.connection.Telemetry().Start(ctx, "schema.PropertyIndexDeleter",
+		telemetry.Collection(p.className))
+	err := p.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (p *PropertyIndexDeleter) do(ctx context.Context) error {
 	path := fmt.Sprintf("/schema/%v/properties/%s/index/%s", p.className, p.propertyName, p.indexName)
 	responseData, err := p.connection.RunREST(ctx, path, http.MethodDelete, nil)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
@@ -35,6 +35,20 @@ func (schema *API) ClassExistenceChecker() *ClassExistenceChecker {
 	}
 }
 
+// DriftChecker builder to compare a schema class with its expected definition
+func (schema *API) DriftChecker() *DriftChecker {
+	return &DriftChecker{
+		connection: schema.connection,
+	}
+}
+
+// Migrator builder to migrate the schema to a desired set of classes
+func (schema *API) Migrator() *Migrator {
+	return &Migrator{
+		api: schema,
+	}
+}
+
 // ClassCreator builder to create a weaviate schema class
 func (schema *API) ClassCreator() *ClassCreator {
 	return &ClassCreator{
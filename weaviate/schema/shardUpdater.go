@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -38,6 +39,13 @@ func (s *ShardUpdater) WithStatus(targetStatus string) *ShardUpdater {
 
 // Do update the status of the shard specified in ShardsGetter
 func (s *ShardUpdater) Do(ctx context.Context) (*models.ShardStatus, error) {
+	ctx, op := s.connection.Telemetry().Start(ctx, "schema.ShardUpdater", telemetry.Collection(s.className))
+	result, err := s.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (s *ShardUpdater) do(ctx context.Context) (*models.ShardStatus, error) {
 	return updateShard(ctx, s.connection, s.className, s.shardName, s.status)
 }
 
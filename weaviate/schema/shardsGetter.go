@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -24,6 +25,13 @@ func (s *ShardsGetter) WithClassName(className string) *ShardsGetter {
 
 // Do get the status of the shards of the class specified in ShardsGetter
 func (s *ShardsGetter) Do(ctx context.Context) ([]*models.ShardStatusGetResponse, error) {
+	ctx, op := s.connection.Telemetry().Start(ctx, "schema.ShardsGetter", telemetry.Collection(s.className))
+	result, err := s.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (s *ShardsGetter) do(ctx context.Context) ([]*models.ShardStatusGetResponse, error) {
 	return getShards(ctx, s.connection, s.className)
 }
 
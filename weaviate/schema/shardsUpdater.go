@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -29,6 +30,13 @@ func (s *ShardsUpdater) WithStatus(targetStatus string) *ShardsUpdater {
 
 // Do update the status of the shards of the class specified in ShardsUpdater
 func (s *ShardsUpdater) Do(ctx context.Context) (UpdateShardsResponse, error) {
+	ctx, op := s.connection.Telemetry().Start(ctx, "schema.ShardsUpdater", telemetry.Collection(s.className))
+	result, err := s.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (s *ShardsUpdater) do(ctx context.Context) (UpdateShardsResponse, error) {
 	shards, err := getShards(ctx, s.connection, s.className)
 	if err != nil {
 		return nil, err
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -31,6 +32,14 @@ func (tc *TenantsCreator) WithTenants(tenants ...models.Tenant) *TenantsCreator
 
 // Add tenants to the class specified in the builder
 func (tc *TenantsCreator) Do(ctx context.Context) error {
+	ctx, op := tc.connection.Telemetry().Start(ctx, "schema.TenantsCreator",
+		telemetry.Collection(tc.className))
+	err := tc.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (tc *TenantsCreator) do(ctx context.Context) error {
 	path := fmt.Sprintf("/schema/%v/tenants", tc.className)
 	responseData, err := tc.connection.RunREST(ctx, path, http.MethodPost, tc.tenants)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
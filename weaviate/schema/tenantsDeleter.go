@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // TenantsDeleter builder object to delete tenants
@@ -30,6 +31,14 @@ func (td *TenantsDeleter) WithTenants(tenants ...string) *TenantsDeleter {
 
 // Deletes tenants from the class specified in the builder
 func (td *TenantsDeleter) Do(ctx context.Context) error {
+	ctx, op := td.connection.Telemetry().Start(ctx, "schema.TenantsDeleter",
+		telemetry.Collection(td.className))
+	err := td.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (td *TenantsDeleter) do(ctx context.Context) error {
 	path := fmt.Sprintf("/schema/%v/tenants", td.className)
 	responseData, err := td.connection.RunREST(ctx, path, http.MethodDelete, td.tenants)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // TenantsExists builder object to check if tenant exists
@@ -30,6 +31,14 @@ func (te *TenantsExists) WithTenant(tenant string) *TenantsExists {
 
 // Do head tenant of given class
 func (te *TenantsExists) Do(ctx context.Context) (bool, error) {
+	ctx, op := te.connection.Telemetry().Start(ctx, "schema.TenantsExists",
+		telemetry.Collection(te.className), telemetry.Tenant(te.tenant))
+	result, err := te.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (te *TenantsExists) do(ctx context.Context) (bool, error) {
 	responseData, err := te.connection.RunREST(ctx, fmt.Sprintf("/schema/%s/tenants/%s", te.className, te.tenant), http.MethodHead, nil)
 	if err != nil {
 		return false, except.NewDerivedWeaviateClientError(err)
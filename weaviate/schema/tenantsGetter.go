@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -24,6 +25,14 @@ func (tg *TenantsGetter) WithClassName(className string) *TenantsGetter {
 
 // Do gets tenants of given class
 func (tg *TenantsGetter) Do(ctx context.Context) ([]models.Tenant, error) {
+	ctx, op := tg.connection.Telemetry().Start(ctx, "schema.TenantsGetter",
+		telemetry.Collection(tg.className))
+	result, err := tg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (tg *TenantsGetter) do(ctx context.Context) ([]models.Tenant, error) {
 	responseData, err := tg.connection.RunREST(ctx, fmt.Sprintf("/schema/%s/tenants", tg.className), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -31,6 +32,14 @@ func (tu *TenantsUpdater) WithTenants(tenants ...models.Tenant) *TenantsUpdater
 
 // Update tenants of the class specified in the builder
 func (tu *TenantsUpdater) Do(ctx context.Context) error {
+	ctx, op := tu.connection.Telemetry().Start(ctx, "schema.TenantsUpdater",
+		telemetry.Collection(tu.className))
+	err := tu.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (tu *TenantsUpdater) do(ctx context.Context) error {
 	path := fmt.Sprintf("/schema/%v/tenants", tu.className)
 	responseData, err := tu.connection.RunREST(ctx, path, http.MethodPut, tu.tenants)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -31,6 +32,13 @@ func (pc *VectorAdder) WithVectors(vectors map[string]models.VectorConfig) *Vect
 }
 
 func (va *VectorAdder) Do(ctx context.Context) error {
+	ctx, op := va.connection.Telemetry().Start(ctx, "schema.VectorAdder", telemetry.Collection(va.className))
+	err := va.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (va *VectorAdder) do(ctx context.Context) error {
 	class, err := va.classGetter.WithClassName(va.className).Do(ctx)
 	if err != nil {
 		return err
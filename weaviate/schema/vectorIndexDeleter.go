@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // VectorIndexDeleter is a builder to delete a vector index from a schema class
@@ -30,6 +31,14 @@ func (v *VectorIndexDeleter) WithVectorIndexName(vectorIndexName string) *Vector
 
 // Do deletes the vector index
 func (v *VectorIndexDeleter) Do(ctx context.Context) error {
+	ctx, op := v.connection.Telemetry().Start(ctx, "schema.VectorIndexDeleter",
+		telemetry.Collection(v.className))
+	err := v.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (v *VectorIndexDeleter) do(ctx context.Context) error {
 	path := fmt.Sprintf("/schema/%v/vectors/%s/index", v.className, v.vectorIndexName)
 	responseData, err := v.connection.RunREST(ctx, path, http.MethodDelete, nil)
 	return except.CheckResponseDataErrorAndStatusCode(responseData, err, 200)
@@ -0,0 +1,73 @@
+package telemetry
+
+import "go.opentelemetry.io/otel/attribute"
+
+const (
+	operationKey        = "weaviate.operation"
+	collectionKey       = "weaviate.collection"
+	tenantKey           = "weaviate.tenant"
+	consistencyLevelKey = "weaviate.consistency_level"
+	objectCountKey      = "weaviate.object_count"
+	backendKey          = "weaviate.backup.backend"
+	statusCodeKey       = "http.response.status_code"
+	methodKey           = "http.request.method"
+	pathKey             = "url.path"
+	protocolKey         = "rpc.system"
+	rpcMethodKey        = "rpc.method"
+	rpcStatusCodeKey    = "rpc.grpc.status_code"
+)
+
+// Collection the operation is executed on
+func Collection(name string) attribute.KeyValue {
+	return attribute.String(collectionKey, name)
+}
+
+// Tenant the operation is executed on
+func Tenant(name string) attribute.KeyValue {
+	return attribute.String(tenantKey, name)
+}
+
+// ConsistencyLevel of the operation
+func ConsistencyLevel(level string) attribute.KeyValue {
+	return attribute.String(consistencyLevelKey, level)
+}
+
+// ObjectCount is the number of objects sent or returned by the operation
+func ObjectCount(count int) attribute.KeyValue {
+	return attribute.Int(objectCountKey, count)
+}
+
+// Backend of a backup operation
+func Backend(name string) attribute.KeyValue {
+	return attribute.String(backendKey, name)
+}
+
+// StatusCode of a REST response
+func StatusCode(code int) attribute.KeyValue {
+	return attribute.Int(statusCodeKey, code)
+}
+
+// Method of a REST request
+func Method(method string) attribute.KeyValue {
+	return attribute.String(methodKey, method)
+}
+
+// Path of a REST request
+func Path(path string) attribute.KeyValue {
+	return attribute.String(pathKey, path)
+}
+
+// GRPCMethod is the full name of the gRPC method which is called
+func GRPCMethod(method string) attribute.KeyValue {
+	return attribute.String(rpcMethodKey, method)
+}
+
+// GRPCStatusCode of a gRPC call
+func GRPCStatusCode(code uint32) attribute.KeyValue {
+	return attribute.Int64(rpcStatusCodeKey, int64(code))
+}
+
+// GRPC marks a request as a gRPC call
+func GRPC() attribute.KeyValue {
+	return attribute.String(protocolKey, "grpc")
+}
@@ -0,0 +1,191 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/fault"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/weaviate/weaviate-go-client/v5/weaviate"
+
+// Config of the OpenTelemetry instrumentation. Tracing and metrics are only
+// enabled if the respective provider is set.
+type Config struct {
+	// TracerProvider used to create spans for every operation and request.
+	TracerProvider trace.TracerProvider
+	// MeterProvider used to record latency, error and batch size metrics.
+	MeterProvider metric.MeterProvider
+	// Propagator used to propagate the trace context to Weaviate via HTTP headers and gRPC metadata.
+	// Defaults to the global propagator, see otel.GetTextMapPropagator.
+	Propagator propagation.TextMapPropagator
+}
+
+// Telemetry creates spans and records metrics. A nil *Telemetry is valid and does nothing.
+type Telemetry struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	operationDuration metric.Float64Histogram
+	operationErrors   metric.Int64Counter
+	requestDuration   metric.Float64Histogram
+	batchSize         metric.Int64Histogram
+}
+
+// New creates the instrumentation from config, it returns nil if config is nil.
+func New(config *Config) (*Telemetry, error) {
+	if config == nil {
+		return nil, nil
+	}
+	t := &Telemetry{propagator: config.Propagator}
+	if t.propagator == nil {
+		t.propagator = otel.GetTextMapPropagator()
+	}
+	if config.TracerProvider != nil {
+		t.tracer = config.TracerProvider.Tracer(instrumentationName)
+	}
+	if config.MeterProvider != nil {
+		if err := t.createInstruments(config.MeterProvider.Meter(instrumentationName)); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+func (t *Telemetry) createInstruments(meter metric.Meter) (err error) {
+	t.operationDuration, err = meter.Float64Histogram("weaviate.client.operation.duration",
+		metric.WithDescription("Duration of client operations"), metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+	t.operationErrors, err = meter.Int64Counter("weaviate.client.operation.errors",
+		metric.WithDescription("Number of failed client operations"))
+	if err != nil {
+		return err
+	}
+	t.requestDuration, err = meter.Float64Histogram("weaviate.client.request.duration",
+		metric.WithDescription("Duration of REST and gRPC requests sent to Weaviate"), metric.WithUnit("s"))
+	if err != nil {
+		return err
+	}
+	t.batchSize, err = meter.Int64Histogram("weaviate.client.batch.size",
+		metric.WithDescription("Number of objects or references sent in a batch request"))
+	return err
+}
+
+// Operation is an instrumented client operation, e.g. a builder's Do call.
+type Operation struct {
+	telemetry *Telemetry
+	name      string
+	kind      trace.SpanKind
+	span      trace.Span
+	start     time.Time
+	attrs     []attribute.KeyValue
+}
+
+// Start an operation, the returned context carries its span.
+func (t *Telemetry) Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, *Operation) {
+	return t.start(ctx, name, trace.SpanKindInternal, attrs)
+}
+
+// StartRequest starts a REST or gRPC request to Weaviate.
+func (t *Telemetry) StartRequest(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, *Operation) {
+	return t.start(ctx, name, trace.SpanKindClient, attrs)
+}
+
+func (t *Telemetry) start(ctx context.Context, name string, kind trace.SpanKind, attrs []attribute.KeyValue) (context.Context, *Operation) {
+	if t == nil {
+		return ctx, nil
+	}
+	op := &Operation{telemetry: t, name: name, kind: kind, start: time.Now(), attrs: attrs}
+	if t.tracer != nil {
+		ctx, op.span = t.tracer.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+	}
+	return ctx, op
+}
+
+// SetAttributes adds attributes to the operation's span and metrics.
+func (o *Operation) SetAttributes(attrs ...attribute.KeyValue) {
+	if o == nil {
+		return
+	}
+	o.attrs = append(o.attrs, attrs...)
+	if o.span != nil {
+		o.span.SetAttributes(attrs...)
+	}
+}
+
+// End the operation and record its outcome.
+func (o *Operation) End(err error) {
+	if o == nil {
+		return
+	}
+	var clientErr *fault.WeaviateClientError
+	if errors.As(err, &clientErr) && clientErr.IsUnexpectedStatusCode {
+		o.SetAttributes(StatusCode(clientErr.StatusCode))
+	}
+	if o.span != nil {
+		if err != nil {
+			o.span.RecordError(err)
+			o.span.SetStatus(codes.Error, err.Error())
+		}
+		o.span.End()
+	}
+
+	t := o.telemetry
+	opts := metric.WithAttributes(o.metricAttributes()...)
+	duration := time.Since(o.start).Seconds()
+	ctx := context.Background()
+	if o.span != nil && o.span.SpanContext().IsValid() {
+		// allows metrics to carry exemplars of the span
+		ctx = trace.ContextWithSpan(ctx, o.span)
+	}
+	if o.kind == trace.SpanKindClient {
+		if t.requestDuration != nil {
+			t.requestDuration.Record(ctx, duration, opts)
+		}
+		return
+	}
+	if t.operationDuration != nil {
+		t.operationDuration.Record(ctx, duration, opts)
+	}
+	if err != nil && t.operationErrors != nil {
+		t.operationErrors.Add(ctx, 1, opts)
+	}
+}
+
+// metricAttributes returns the low cardinality attributes of the operation,
+// tenants and object counts are only recorded on spans.
+func (o *Operation) metricAttributes() []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String(operationKey, o.name)}
+	for _, attr := range o.attrs {
+		switch attr.Key {
+		case collectionKey, consistencyLevelKey, statusCodeKey, protocolKey, methodKey:
+			attrs = append(attrs, attr)
+		}
+	}
+	return attrs
+}
+
+// RecordBatchSize records the number of objects or references sent in a batch.
+func (t *Telemetry) RecordBatchSize(ctx context.Context, size int, attrs ...attribute.KeyValue) {
+	if t == nil || t.batchSize == nil {
+		return
+	}
+	t.batchSize.Record(ctx, int64(size), metric.WithAttributes(attrs...))
+}
+
+// Inject propagates the trace context of ctx into carrier.
+func (t *Telemetry) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	if t == nil {
+		return
+	}
+	t.propagator.Inject(ctx, carrier)
+}
@@ -0,0 +1,73 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/fault"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newTestTelemetry(t *testing.T) (*Telemetry, *tracetest.SpanRecorder) {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	tel, err := New(&Config{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		Propagator:     propagation.TraceContext{},
+	})
+	require.NoError(t, err)
+	return tel, recorder
+}
+
+func TestTelemetry_Nil(t *testing.T) {
+	tel, err := New(nil)
+	require.NoError(t, err)
+	assert.Nil(t, tel)
+
+	ctx, op := tel.Start(context.Background(), "data.Creator", Collection("Article"))
+	op.SetAttributes(Tenant("tenant"))
+	op.End(errors.New("failed"))
+	tel.RecordBatchSize(ctx, 10)
+	tel.Inject(ctx, propagation.HeaderCarrier(http.Header{}))
+}
+
+func TestTelemetry_Start(t *testing.T) {
+	tel, recorder := newTestTelemetry(t)
+
+	ctx, op := tel.Start(context.Background(), "batch.ObjectsBatcher", ObjectCount(2))
+	_, request := tel.StartRequest(ctx, http.MethodPost, Method(http.MethodPost))
+	request.End(nil)
+	op.End(&fault.WeaviateClientError{IsUnexpectedStatusCode: true, StatusCode: 422, Msg: "invalid"})
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	requestSpan, operationSpan := spans[0], spans[1]
+
+	assert.Equal(t, "batch.ObjectsBatcher", operationSpan.Name())
+	assert.Equal(t, trace.SpanKindInternal, operationSpan.SpanKind())
+	assert.Equal(t, codes.Error, operationSpan.Status().Code)
+	assert.Contains(t, operationSpan.Attributes(), attribute.Int(objectCountKey, 2))
+	assert.Contains(t, operationSpan.Attributes(), attribute.Int(statusCodeKey, 422))
+
+	assert.Equal(t, trace.SpanKindClient, requestSpan.SpanKind())
+	assert.Equal(t, operationSpan.SpanContext().SpanID(), requestSpan.Parent().SpanID())
+}
+
+func TestTelemetry_Inject(t *testing.T) {
+	tel, _ := newTestTelemetry(t)
+
+	ctx, op := tel.Start(context.Background(), "graphql.GetBuilder")
+	defer op.End(nil)
+	header := http.Header{}
+	tel.Inject(ctx, propagation.HeaderCarrier(header))
+	assert.NotEmpty(t, header.Get("traceparent"))
+}
@@ -0,0 +1,95 @@
+package weaviate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/alias"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/classifications"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/contextionary"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/groups"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/tokenize"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestClient_Telemetry(t *testing.T) {
+	var mutex sync.Mutex
+	traceparents := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		traceparents[r.URL.Path] = r.Header.Get("traceparent")
+		mutex.Unlock()
+		if strings.HasPrefix(r.URL.Path, "/v1/authz/") {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tel, err := telemetry.New(&telemetry.Config{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		Propagator:     propagation.TraceContext{},
+	})
+	require.NoError(t, err)
+	con := connection.NewConnection("http", strings.TrimPrefix(server.URL, "http://"), nil, time.Second, nil).
+		WithTelemetry(tel)
+	ctx := context.Background()
+
+	for _, test := range []struct {
+		name string
+		path string
+		do   func() error
+	}{
+		{"alias.Getter", "/v1/aliases", func() error {
+			_, err := alias.New(con).Getter().WithClassName("Article").Do(ctx)
+			return err
+		}},
+		{"groups.KnownGroupLister", "/v1/authz/groups/oidc", func() error {
+			_, err := groups.New(con).OIDC().GetKnownGroups().Do(ctx)
+			return err
+		}},
+		{"classifications.Getter", "/v1/classifications/1", func() error {
+			_, err := classifications.New(con).Getter().WithID("1").Do(ctx)
+			return err
+		}},
+		{"contextionary.ConceptGetter", "/v1/modules/text2vec-contextionary/concepts/article", func() error {
+			_, err := contextionary.New(con).ConceptsGetter().WithConcept("article").Do(ctx)
+			return err
+		}},
+		{"tokenize.PropertyTokenizer", "/v1/schema/Article/properties/title/tokenize", func() error {
+			_, err := tokenize.New(con).Property().WithClassName("Article").WithPropertyName("title").WithText("a").Do(ctx)
+			return err
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			recorder.Reset()
+			require.NoError(t, test.do())
+
+			var operation sdktrace.ReadOnlySpan
+			for _, span := range recorder.Ended() {
+				if span.SpanKind() == trace.SpanKindInternal {
+					operation = span
+				}
+			}
+			require.NotNil(t, operation)
+			assert.Equal(t, test.name, operation.Name())
+			mutex.Lock()
+			defer mutex.Unlock()
+			assert.Contains(t, traceparents[test.path], operation.SpanContext().TraceID().String())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/connection"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/except"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 )
 
 // PropertyTokenizer is a builder for
@@ -41,12 +42,19 @@ func (p *PropertyTokenizer) WithText(text string) *PropertyTokenizer {
 
 // Do performs the tokenize request.
 func (p *PropertyTokenizer) Do(ctx context.Context) (*TokenizeResult, error) {
+	ctx, op := p.connection.Telemetry().Start(ctx, "tokenize.PropertyTokenizer", telemetry.Collection(p.className))
+	result, err := p.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (p *PropertyTokenizer) do(ctx context.Context) (*TokenizeResult, error) {
 	path := fmt.Sprintf("/schema/%s/properties/%s/tokenize", p.className, p.propertyName)
 	payload := struct {
 		Text string `json:"text"`
 	}{Text: p.text}
 
-	responseData, err := p.connection.RunREST(ctx, path, http.MethodPost, payload)
+	responseData, err := p.connection.RunREST(connection.WithRetryNonIdempotent(ctx), path, http.MethodPost, payload)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
 	}
@@ -46,6 +46,13 @@ func (b *TextTokenizer) WithStopwordPresets(presets map[string]*StopwordConfig)
 
 // Do performs the tokenize request.
 func (b *TextTokenizer) Do(ctx context.Context) (*TokenizeResult, error) {
+	ctx, op := b.connection.Telemetry().Start(ctx, "tokenize.TextTokenizer")
+	result, err := b.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (b *TextTokenizer) do(ctx context.Context) (*TokenizeResult, error) {
 	payload := tokenizeRequest{
 		Text:            b.text,
 		Tokenization:    b.tokenization,
@@ -53,7 +60,7 @@ func (b *TextTokenizer) Do(ctx context.Context) (*TokenizeResult, error) {
 		StopwordPresets: b.stopwordPresets,
 	}
 
-	responseData, err := b.connection.RunREST(ctx, "/tokenize", http.MethodPost, payload)
+	responseData, err := b.connection.RunREST(connection.WithRetryNonIdempotent(ctx), "/tokenize", http.MethodPost, payload)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
 	}
@@ -0,0 +1,353 @@
+package typed
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+)
+
+// additional is the key of the metadata in GraphQL results, which holds the
+// ID and vectors of an object
+const additional = "_additional"
+
+// FromObject converts an object to a T, a struct or a pointer to one
+func FromObject[T any](object *models.Object) (T, error) {
+	var value T
+	if object == nil {
+		return value, nil
+	}
+	err := Decode(objectMap(object), &value)
+	return value, err
+}
+
+// FromObjects converts objects to Ts, a struct or a pointer to one
+func FromObjects[T any](objects []*models.Object) ([]T, error) {
+	values := make([]T, len(objects))
+	for i, object := range objects {
+		value, err := FromObject[T](object)
+		if err != nil {
+			return nil, fmt.Errorf("object %d: %w", i, err)
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// Decode sets the fields of the struct dst points to from the properties of an object,
+// as returned by GraphQL Get queries. The ID and vectors of the object are read from
+// the "_additional" properties. Cross-references hold either the properties of the
+// referenced objects or their beacons.
+func Decode(properties map[string]interface{}, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return fmt.Errorf("typed: expected a non-nil pointer, got %T", dst)
+	}
+	if _, err := structType(v.Type()); err != nil {
+		return fmt.Errorf("typed: %w", err)
+	}
+	if err := decodeValue(v.Elem(), properties); err != nil {
+		return fmt.Errorf("typed: %w", err)
+	}
+	return nil
+}
+
+// objectMap returns the properties of an object with its ID and vectors as additional properties
+func objectMap(object *models.Object) map[string]interface{} {
+	properties, _ := object.Properties.(map[string]interface{})
+	m := make(map[string]interface{}, len(properties)+1)
+	for name, value := range properties {
+		m[name] = value
+	}
+	meta := map[string]interface{}{"id": object.ID.String()}
+	if len(object.Vector) > 0 {
+		meta["vector"] = []float32(object.Vector)
+	}
+	if len(object.Vectors) > 0 {
+		vectors := make(map[string]interface{}, len(object.Vectors))
+		for name, vector := range object.Vectors {
+			vectors[name] = vector
+		}
+		meta["vectors"] = vectors
+	}
+	m[additional] = meta
+	return m
+}
+
+func decodeStruct(v reflect.Value, m map[string]interface{}) error {
+	fs, err := fields(v.Type())
+	if err != nil {
+		return err
+	}
+	meta, _ := m[additional].(map[string]interface{})
+	for _, f := range fs {
+		fv := v.FieldByIndex(f.index)
+		switch f.kind {
+		case kindID:
+			if id := idOf(m); id != "" {
+				if err := decodeValue(fv, id); err != nil {
+					return fmt.Errorf("id: %w", err)
+				}
+			}
+		case kindVector:
+			raw := meta["vector"]
+			if f.name != "" {
+				vectors, _ := meta["vectors"].(map[string]interface{})
+				raw = vectors[f.name]
+			}
+			if err := decodeValue(fv, raw); err != nil {
+				return fmt.Errorf("vector %q: %w", f.name, err)
+			}
+		case kindReference:
+			if err := decodeReferences(fv, m[f.name]); err != nil {
+				return fmt.Errorf("reference %s: %w", f.name, err)
+			}
+		default:
+			if err := decodeValue(fv, m[f.name]); err != nil {
+				return fmt.Errorf("property %s: %w", f.name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// idOf returns the ID of an object from its additional properties, or from its beacon
+// if it is a reference
+func idOf(m map[string]interface{}) string {
+	if meta, ok := m[additional].(map[string]interface{}); ok {
+		if id, ok := meta["id"].(string); ok {
+			return id
+		}
+	}
+	if beacon, ok := m["beacon"].(string); ok {
+		if ref, err := crossref.Parse(beacon); err == nil {
+			return ref.TargetID.String()
+		}
+	}
+	return ""
+}
+
+func decodeReferences(v reflect.Value, raw interface{}) error {
+	if raw == nil {
+		return nil
+	}
+	items := reflect.ValueOf(raw)
+	if items.Kind() != reflect.Slice {
+		return fmt.Errorf("cannot decode %T into %s", raw, v.Type())
+	}
+	if v.Kind() != reflect.Slice {
+		if items.Len() == 0 {
+			return nil
+		}
+		return decodeReference(v, items.Index(0).Interface())
+	}
+	refs := reflect.MakeSlice(v.Type(), items.Len(), items.Len())
+	for i := range items.Len() {
+		if err := decodeReference(refs.Index(i), items.Index(i).Interface()); err != nil {
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	v.Set(refs)
+	return nil
+}
+
+func decodeReference(v reflect.Value, raw interface{}) error {
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("cannot decode %T into %s", raw, v.Type())
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	switch {
+	case v.Type() == referenceType:
+		ref := Reference{ID: strfmt.UUID(idOf(m))}
+		if beacon, ok := m["beacon"].(string); ok {
+			if parsed, err := crossref.Parse(beacon); err == nil {
+				ref.Class = parsed.Class
+			}
+		}
+		v.Set(reflect.ValueOf(ref))
+		return nil
+	case v.Kind() == reflect.Struct:
+		return decodeStruct(v, m)
+	case v.Kind() == reflect.String:
+		v.SetString(idOf(m))
+		return nil
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// decodeValue sets v from the value of a property, as decoded from JSON or converted
+// from a gRPC search result
+func decodeValue(v reflect.Value, raw interface{}) error {
+	if raw == nil {
+		return nil
+	}
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeValue(v.Elem(), raw)
+	}
+	t := v.Type()
+	switch {
+	case t == geoType, t == phoneType:
+		return decodeJSON(v, raw)
+	case reflect.PointerTo(t).Implements(textUnmarshalerType):
+		// dates, UUIDs
+		s, ok := raw.(string)
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", raw, t)
+		}
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	switch v.Kind() {
+	case reflect.Interface:
+		rv := reflect.ValueOf(raw)
+		if !rv.Type().AssignableTo(t) {
+			return fmt.Errorf("cannot decode %T into %s", raw, t)
+		}
+		v.Set(rv)
+	case reflect.String:
+		s, ok := raw.(string)
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", raw, t)
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b, ok := raw.(bool)
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", raw, t)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := toInt64(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := toInt64(raw)
+		if err != nil {
+			return err
+		}
+		if i < 0 {
+			return fmt.Errorf("cannot decode %d into %s", i, t)
+		}
+		v.SetUint(uint64(i))
+	case reflect.Float32, reflect.Float64:
+		f, err := toFloat64(raw)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Struct:
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("cannot decode %T into %s", raw, t)
+		}
+		return decodeStruct(v, m)
+	case reflect.Map:
+		m, ok := raw.(map[string]interface{})
+		if !ok || t.Key().Kind() != reflect.String {
+			return fmt.Errorf("cannot decode %T into %s", raw, t)
+		}
+		result := reflect.MakeMapWithSize(t, len(m))
+		for key, item := range m {
+			value := reflect.New(t.Elem()).Elem()
+			if err := decodeValue(value, item); err != nil {
+				return fmt.Errorf("key %s: %w", key, err)
+			}
+			result.SetMapIndex(reflect.ValueOf(key).Convert(t.Key()), value)
+		}
+		v.Set(result)
+	case reflect.Slice:
+		if s, ok := raw.(string); ok && t.Elem().Kind() == reflect.Uint8 {
+			// blobs
+			blob, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return err
+			}
+			v.SetBytes(blob)
+			return nil
+		}
+		items := reflect.ValueOf(raw)
+		if items.Kind() != reflect.Slice {
+			return fmt.Errorf("cannot decode %T into %s", raw, t)
+		}
+		list := reflect.MakeSlice(t, items.Len(), items.Len())
+		for i := range items.Len() {
+			if err := decodeValue(list.Index(i), items.Index(i).Interface()); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+		v.Set(list)
+	default:
+		return fmt.Errorf("unsupported type %s", t)
+	}
+	return nil
+}
+
+// decodeJSON decodes geo coordinates and phone numbers, which are JSON objects
+func decodeJSON(v reflect.Value, raw interface{}) error {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v.Addr().Interface())
+}
+
+func toInt64(raw interface{}) (int64, error) {
+	switch n := raw.(type) {
+	case int:
+		return int64(n), nil
+	case int32:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case uint64:
+		return int64(n), nil
+	case json.Number:
+		return n.Int64()
+	case float32:
+		return toInt64(float64(n))
+	case float64:
+		if n != float64(int64(n)) {
+			return 0, fmt.Errorf("%v is not an integer", n)
+		}
+		return int64(n), nil
+	default:
+		return 0, fmt.Errorf("cannot decode %T into an integer", raw)
+	}
+}
+
+func toFloat64(raw interface{}) (float64, error) {
+	switch n := raw.(type) {
+	case int:
+		return float64(n), nil
+	case int32:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case uint64:
+		return float64(n), nil
+	case json.Number:
+		return n.Float64()
+	case float32:
+		return float64(n), nil
+	case float64:
+		return n, nil
+	default:
+		return 0, fmt.Errorf("cannot decode %T into a number", raw)
+	}
+}
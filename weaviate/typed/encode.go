@@ -0,0 +1,335 @@
+package typed
+
+import (
+	"encoding"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	referenceType       = reflect.TypeFor[Reference]()
+	geoType             = reflect.TypeFor[models.GeoCoordinates]()
+	phoneType           = reflect.TypeFor[models.PhoneNumber]()
+)
+
+// Reference to an object of a class, for cross-reference fields which do not
+// hold the referenced objects
+type Reference struct {
+	Class string
+	ID    strfmt.UUID
+}
+
+// Beacon of the referenced object
+func (r Reference) Beacon() string {
+	if r.Class == "" {
+		return fmt.Sprintf("weaviate://localhost/%v", r.ID)
+	}
+	return fmt.Sprintf("weaviate://localhost/%v/%v", r.Class, r.ID)
+}
+
+// ToObject converts a struct, or a pointer to one, to an object of the class
+func ToObject(className string, value any) (*models.Object, error) {
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("typed: expected a struct, got %T", value)
+	}
+	object := &models.Object{Class: className}
+	properties, err := encodeStruct(v, object)
+	if err != nil {
+		return nil, fmt.Errorf("typed: %w", err)
+	}
+	object.Properties = properties
+	return object, nil
+}
+
+// ToObjects converts the values to objects of the class, to be added to a batch
+func ToObjects[T any](className string, values []T) ([]*models.Object, error) {
+	objects := make([]*models.Object, len(values))
+	for i := range values {
+		object, err := ToObject(className, values[i])
+		if err != nil {
+			return nil, fmt.Errorf("object %d: %w", i, err)
+		}
+		objects[i] = object
+	}
+	return objects, nil
+}
+
+// ToProperties converts a struct, or a pointer to one, to the properties of an object.
+// IDs and vectors are left out.
+func ToProperties(value any) (map[string]interface{}, error) {
+	object, err := ToObject("", value)
+	if err != nil {
+		return nil, err
+	}
+	return object.Properties.(map[string]interface{}), nil
+}
+
+// encodeStruct returns the properties of a struct and sets the ID and vectors of the
+// object, if it is not nil
+func encodeStruct(v reflect.Value, object *models.Object) (map[string]interface{}, error) {
+	fs, err := fields(v.Type())
+	if err != nil {
+		return nil, err
+	}
+	properties := make(map[string]interface{}, len(fs))
+	for _, f := range fs {
+		fv := v.FieldByIndex(f.index)
+		if isNil(fv) || ((f.omitEmpty || f.kind != kindProperty) && isEmpty(fv)) {
+			continue
+		}
+		switch f.kind {
+		case kindID:
+			if object != nil {
+				id, err := encodeID(fv)
+				if err != nil {
+					return nil, fmt.Errorf("id: %w", err)
+				}
+				object.ID = strfmt.UUID(id)
+			}
+		case kindVector:
+			if object != nil {
+				if err := encodeVector(f.name, fv, object); err != nil {
+					return nil, err
+				}
+			}
+		case kindReference:
+			refs, err := encodeReferences(fv, f.refClass)
+			if err != nil {
+				return nil, fmt.Errorf("reference %s: %w", f.name, err)
+			}
+			properties[f.name] = refs
+		default:
+			value, err := encodeValue(fv)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", f.name, err)
+			}
+			properties[f.name] = value
+		}
+	}
+	return properties, nil
+}
+
+// isNil reports whether v is a nil pointer, slice or map, which are never sent
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Slice, reflect.Map:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
+
+func encodeID(v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	return "", fmt.Errorf("unsupported type %s", v.Type())
+}
+
+func encodeVector(name string, v reflect.Value, object *models.Object) error {
+	switch vector := v.Interface().(type) {
+	case models.C11yVector:
+		return encodeVector(name, reflect.ValueOf([]float32(vector)), object)
+	case []float32:
+		if name == "" {
+			object.Vector = vector
+			return nil
+		}
+		if object.Vectors == nil {
+			object.Vectors = models.Vectors{}
+		}
+		object.Vectors[name] = vector
+	case [][]float32:
+		if name == "" {
+			return fmt.Errorf("multi vectors must be named")
+		}
+		if object.Vectors == nil {
+			object.Vectors = models.Vectors{}
+		}
+		object.Vectors[name] = vector
+	default:
+		return fmt.Errorf("vector %q: unsupported type %s", name, v.Type())
+	}
+	return nil
+}
+
+// encodeReferences returns the beacons of a Reference, a struct with an id field,
+// or a slice or pointer of them
+func encodeReferences(v reflect.Value, class string) ([]map[string]interface{}, error) {
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		ref, err := encodeReference(v, class)
+		if err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{ref}, nil
+	}
+	refs := make([]map[string]interface{}, 0, v.Len())
+	for i := range v.Len() {
+		item := v.Index(i)
+		if item.Kind() == reflect.Pointer && item.IsNil() {
+			continue
+		}
+		ref, err := encodeReference(reflect.Indirect(item), class)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+func encodeReference(v reflect.Value, class string) (map[string]interface{}, error) {
+	switch {
+	case v.Type() == referenceType:
+		ref := v.Interface().(Reference)
+		if ref.Class == "" {
+			ref.Class = class
+		}
+		return map[string]interface{}{"beacon": ref.Beacon()}, nil
+	case v.Kind() == reflect.Struct:
+		object := &models.Object{}
+		if _, err := encodeStruct(v, object); err != nil {
+			return nil, err
+		}
+		if object.ID == "" {
+			return nil, fmt.Errorf("referenced %s has no id", v.Type())
+		}
+		return map[string]interface{}{"beacon": Reference{Class: class, ID: object.ID}.Beacon()}, nil
+	case v.Kind() == reflect.String:
+		return map[string]interface{}{"beacon": Reference{Class: class, ID: strfmt.UUID(v.String())}.Beacon()}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", v.Type())
+	}
+}
+
+// encodeValue converts a field to the value of a property. Lists are converted
+// to typed slices and nested objects to maps, as the gRPC batch expects them.
+func encodeValue(v reflect.Value) (interface{}, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	t := v.Type()
+	switch {
+	case t == geoType:
+		geo := v.Interface().(models.GeoCoordinates)
+		return map[string]interface{}{"latitude": deref(geo.Latitude), "longitude": deref(geo.Longitude)}, nil
+	case t == phoneType:
+		phone := v.Interface().(models.PhoneNumber)
+		value := map[string]interface{}{"input": phone.Input}
+		if phone.DefaultCountry != "" {
+			value["defaultCountry"] = phone.DefaultCountry
+		}
+		return value, nil
+	case t.Implements(textMarshalerType):
+		// dates, UUIDs
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Struct:
+		return encodeStruct(v, nil)
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported map key type %s", t.Key())
+		}
+		value := make(map[string]interface{}, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			item, err := encodeValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			value[iter.Key().String()] = item
+		}
+		return value, nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			// blobs
+			return base64.StdEncoding.EncodeToString(v.Bytes()), nil
+		}
+		return encodeList(v)
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+func encodeList(v reflect.Value) (interface{}, error) {
+	items := make([]interface{}, v.Len())
+	for i := range v.Len() {
+		item, err := encodeValue(v.Index(i))
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		items[i] = item
+	}
+	switch elem := v.Type().Elem(); {
+	case elem.Implements(textMarshalerType), elem.Kind() == reflect.String:
+		return typedList[string](items), nil
+	case elem.Kind() == reflect.Bool:
+		return typedList[bool](items), nil
+	case elem.Kind() >= reflect.Int && elem.Kind() <= reflect.Uint64:
+		return typedList[int64](items), nil
+	case elem.Kind() == reflect.Float32 || elem.Kind() == reflect.Float64:
+		return typedList[float64](items), nil
+	default:
+		// nested objects
+		return items, nil
+	}
+}
+
+func typedList[T any](items []interface{}) []T {
+	list := make([]T, len(items))
+	for i, item := range items {
+		list[i], _ = item.(T)
+	}
+	return list
+}
+
+func deref[T any](p *T) T {
+	var value T
+	if p != nil {
+		value = *p
+	}
+	return value
+}
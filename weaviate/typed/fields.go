@@ -0,0 +1,145 @@
+// Package typed maps Go structs to Weaviate objects and back, and derives the
+// classes of the objects from them.
+//
+// The properties of an object are the exported fields of a struct. A field is named
+// after its `weaviate` tag, or after the field name with a lower case first letter.
+// The tag can hold comma separated options after the name:
+//
+//	type Article struct {
+//		ID        strfmt.UUID           `weaviate:",id"`
+//		Title     string                `weaviate:"title"`
+//		Published time.Time             `weaviate:"published,omitempty"`
+//		Location  models.GeoCoordinates `weaviate:"location"`
+//		Author    *Author               `weaviate:"hasAuthor,ref=Author"`
+//		Vector    []float32             `weaviate:",vector"`
+//		Summary   []float32             `weaviate:"summary,vector"`
+//		Internal  string                `weaviate:"-"`
+//	}
+//
+// The option id marks the UUID of the object and vector its default vector, or the
+// named vector if the tag holds a name. Cross-references are marked with ref, the
+// target class is set with ref=Class or taken from Reference values. Fields of other
+// structs are nested object properties.
+package typed
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// fieldKind tells how a field is mapped to an object
+type fieldKind int
+
+const (
+	kindProperty fieldKind = iota
+	kindID
+	kindVector
+	kindReference
+)
+
+type field struct {
+	name      string
+	index     []int
+	typ       reflect.Type
+	kind      fieldKind
+	omitEmpty bool
+	refClass  string
+	options   map[string]string
+}
+
+var fieldCache sync.Map // reflect.Type -> []field
+
+// fields returns the mapped fields of a struct type
+func fields(t reflect.Type) ([]field, error) {
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.([]field), nil
+	}
+	result, err := structFields(t, nil)
+	if err != nil {
+		return nil, err
+	}
+	fieldCache.Store(t, result)
+	return result, nil
+}
+
+func structFields(t reflect.Type, index []int) ([]field, error) {
+	var result []field
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		tag, tagged := sf.Tag.Lookup("weaviate")
+		if tag == "-" {
+			continue
+		}
+		fieldIndex := append(append([]int{}, index...), i)
+		if sf.Anonymous && !tagged && sf.Type.Kind() == reflect.Struct {
+			embedded, err := structFields(sf.Type, fieldIndex)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, embedded...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		f, err := parseTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %w", sf.Name, t, err)
+		}
+		if f.name == "" && f.kind != kindID && f.kind != kindVector {
+			f.name = lowerFirst(sf.Name)
+		}
+		f.index, f.typ = fieldIndex, sf.Type
+		result = append(result, f)
+	}
+	return result, nil
+}
+
+func parseTag(tag string) (field, error) {
+	name, opts, _ := strings.Cut(tag, ",")
+	f := field{name: name, options: map[string]string{}}
+	if opts == "" {
+		return f, nil
+	}
+	for _, opt := range strings.Split(opts, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(opt), "=")
+		switch key {
+		case "id":
+			f.kind = kindID
+		case "vector":
+			f.kind = kindVector
+		case "ref":
+			f.kind, f.refClass = kindReference, value
+		case "omitempty":
+			f.omitEmpty = true
+		case "":
+		default:
+			// further options describe the schema of the property
+			f.options[key] = value
+		}
+	}
+	if f.kind == kindID && f.name != "" {
+		return f, fmt.Errorf("id field cannot be named %q", f.name)
+	}
+	return f, nil
+}
+
+func lowerFirst(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToLower(r)) + s[size:]
+}
+
+// structType returns the struct type of v, which has to be a struct or a pointer to one
+func structType(t reflect.Type) (reflect.Type, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a struct, got %s", t)
+	}
+	return t, nil
+}
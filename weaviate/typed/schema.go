@@ -0,0 +1,244 @@
+package typed
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	uuidType     = reflect.TypeFor[strfmt.UUID]()
+	timeType     = reflect.TypeFor[time.Time]()
+	dateTimeType = reflect.TypeFor[strfmt.DateTime]()
+)
+
+// Class derives the class of T, a struct mapped with `weaviate` tags. It is named
+// after className, or after the type if className is empty. The data types of the
+// properties follow from the field types and can be set with the dataType option,
+// e.g. for UUIDs held as strings. Further options of properties are
+//
+//	tokenization=word     tokenization of text properties
+//	filterable=false      sets indexFilterable
+//	searchable=false      sets indexSearchable
+//	rangeFilters=true     sets indexRangeFilters
+//
+// Vector fields add a named vector, or set the vectorizer of the class for the default
+// vector. Their options are vectorizer=text2vec-openai, "none" by default, and
+// index=hnsw, the type of the vector index.
+func Class[T any](className string) (*models.Class, error) {
+	t, err := structType(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, fmt.Errorf("typed: %w", err)
+	}
+	if className == "" {
+		className = t.Name()
+	}
+	class := &models.Class{Class: className, Properties: []*models.Property{}}
+	fs, err := fields(t)
+	if err != nil {
+		return nil, fmt.Errorf("typed: %w", err)
+	}
+	for _, f := range fs {
+		switch f.kind {
+		case kindID:
+		case kindVector:
+			if err := addVector(class, f); err != nil {
+				return nil, fmt.Errorf("typed: %w", err)
+			}
+		default:
+			property, err := classProperty(f, t)
+			if err != nil {
+				return nil, fmt.Errorf("typed: class %s: %w", className, err)
+			}
+			class.Properties = append(class.Properties, property)
+		}
+	}
+	return class, nil
+}
+
+func addVector(class *models.Class, f field) error {
+	vectorizer := f.options["vectorizer"]
+	if vectorizer == "" {
+		vectorizer = "none"
+	}
+	if f.name == "" {
+		class.Vectorizer = vectorizer
+		class.VectorIndexType = f.options["index"]
+		return nil
+	}
+	if _, ok := class.VectorConfig[f.name]; ok {
+		return fmt.Errorf("duplicate vector %q", f.name)
+	}
+	if class.VectorConfig == nil {
+		class.VectorConfig = map[string]models.VectorConfig{}
+	}
+	indexType := f.options["index"]
+	if indexType == "" {
+		indexType = "hnsw"
+	}
+	class.VectorConfig[f.name] = models.VectorConfig{
+		Vectorizer:      map[string]interface{}{vectorizer: map[string]interface{}{}},
+		VectorIndexType: indexType,
+	}
+	return nil
+}
+
+// classProperty derives the property of field f of the struct t
+func classProperty(f field, t reflect.Type) (*models.Property, error) {
+	nested, err := nestedProperty(f, []reflect.Type{t})
+	if err != nil {
+		return nil, err
+	}
+	return &models.Property{
+		Name:              nested.Name,
+		DataType:          nested.DataType,
+		Tokenization:      nested.Tokenization,
+		IndexFilterable:   nested.IndexFilterable,
+		IndexSearchable:   nested.IndexSearchable,
+		IndexRangeFilters: nested.IndexRangeFilters,
+		NestedProperties:  nested.NestedProperties,
+	}, nil
+}
+
+// nestedProperty derives the property of field f. The structs holding f are passed
+// along, as a struct nesting itself has no schema.
+func nestedProperty(f field, parents []reflect.Type) (*models.NestedProperty, error) {
+	property := &models.NestedProperty{Name: f.name, Tokenization: f.options["tokenization"]}
+	var err error
+	if property.IndexFilterable, err = boolOption(f, "filterable"); err != nil {
+		return nil, err
+	}
+	if property.IndexSearchable, err = boolOption(f, "searchable"); err != nil {
+		return nil, err
+	}
+	if property.IndexRangeFilters, err = boolOption(f, "rangeFilters"); err != nil {
+		return nil, err
+	}
+
+	if f.kind == kindReference {
+		target := f.refClass
+		if target == "" {
+			if t, err := structType(elemType(f.typ)); err == nil && t != referenceType {
+				target = t.Name()
+			}
+		}
+		if target == "" {
+			return nil, fmt.Errorf("reference %s: set the target class with ref=Class", f.name)
+		}
+		property.DataType = []string{target}
+		return property, nil
+	}
+
+	if dataType := f.options["dataType"]; dataType != "" {
+		property.DataType = []string{dataType}
+	} else if property.DataType, err = dataTypeOf(f.typ); err != nil {
+		return nil, fmt.Errorf("property %s: %w", f.name, err)
+	}
+	if dataType := property.DataType[0]; dataType == "object" || dataType == "object[]" {
+		t, err := structType(elemType(f.typ))
+		if err != nil {
+			return nil, fmt.Errorf("property %s: nested properties: %w", f.name, err)
+		}
+		if slices.Contains(parents, t) {
+			return nil, fmt.Errorf("property %s: %s nests itself", f.name, t)
+		}
+		nestedFields, err := fields(t)
+		if err != nil {
+			return nil, err
+		}
+		for _, nf := range nestedFields {
+			if nf.kind != kindProperty {
+				return nil, fmt.Errorf("property %s: nested objects hold only properties, not %s", f.name, nf.typ)
+			}
+			np, err := nestedProperty(nf, append(slices.Clip(parents), t))
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", f.name, err)
+			}
+			property.NestedProperties = append(property.NestedProperties, np)
+		}
+	}
+	return property, nil
+}
+
+func boolOption(f field, name string) (*bool, error) {
+	value, ok := f.options[name]
+	if !ok {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("property %s: option %s: %w", f.name, name, err)
+	}
+	return &b, nil
+}
+
+// elemType returns the type of the values held by pointers, slices and arrays of t
+func elemType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	return t
+}
+
+// dataTypeOf returns the data type of a property held in a field of type t
+func dataTypeOf(t reflect.Type) ([]string, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if dataType := scalarDataType(t); dataType != "" {
+		return []string{dataType}, nil
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		elem := t.Elem()
+		for elem.Kind() == reflect.Pointer {
+			elem = elem.Elem()
+		}
+		dataType := scalarDataType(elem)
+		if dataType == "" && elem.Kind() == reflect.Struct {
+			dataType = "object"
+		}
+		if dataType == "" || slices.Contains([]string{"blob", "geoCoordinates", "phoneNumber"}, dataType) {
+			return nil, fmt.Errorf("unsupported list of %s", elem)
+		}
+		return []string{dataType + "[]"}, nil
+	}
+	if t.Kind() == reflect.Struct {
+		return []string{"object"}, nil
+	}
+	return nil, fmt.Errorf("no data type for %s, set it with the dataType option", t)
+}
+
+func scalarDataType(t reflect.Type) string {
+	switch {
+	case t == geoType:
+		return "geoCoordinates"
+	case t == phoneType:
+		return "phoneNumber"
+	case t == uuidType, t.PkgPath() == "github.com/google/uuid" && t.Name() == "UUID":
+		return "uuid"
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		return "blob"
+	case t == timeType, t == dateTimeType:
+		return "date"
+	case t.Implements(textMarshalerType):
+		return "text"
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "text"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "int"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return ""
+	}
+}
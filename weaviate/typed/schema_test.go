@@ -0,0 +1,109 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestClass(t *testing.T) {
+	class, err := Class[article]("Article")
+	require.NoError(t, err)
+	assert.Equal(t, "Article", class.Class)
+	assert.Equal(t, "none", class.Vectorizer)
+	assert.Equal(t, map[string]models.VectorConfig{
+		"summary": {Vectorizer: map[string]interface{}{"none": map[string]interface{}{}}, VectorIndexType: "hnsw"},
+	}, class.VectorConfig)
+
+	dataTypes := map[string][]string{}
+	for _, p := range class.Properties {
+		dataTypes[p.Name] = p.DataType
+	}
+	assert.Equal(t, map[string][]string{
+		"title":      {"text"},
+		"wordCount":  {"int"},
+		"score":      {"number"},
+		"published":  {"date"},
+		"tags":       {"text[]"},
+		"location":   {"geoCoordinates"},
+		"phone":      {"phoneNumber"},
+		"sections":   {"object[]"},
+		"hasAuthors": {"Author"},
+		"related":    {"Article"},
+	}, dataTypes)
+	assert.Equal(t, []*models.NestedProperty{
+		{Name: "heading", DataType: []string{"text"}},
+		{Name: "words", DataType: []string{"int"}},
+	}, class.Properties[7].NestedProperties)
+
+	t.Run("options", func(t *testing.T) {
+		type document struct {
+			Key     string    `weaviate:"key,dataType=uuid,filterable=true,searchable=false"`
+			Body    string    `weaviate:"body,tokenization=field"`
+			Created int64     `weaviate:"created,rangeFilters=true"`
+			Author  author    `weaviate:"author,ref"`
+			Vector  []float32 `weaviate:"body_vector,vector,vectorizer=text2vec-openai,index=flat"`
+		}
+		class, err := Class[*document]("")
+		require.NoError(t, err)
+		assert.Equal(t, "document", class.Class)
+		yes, no := true, false
+		assert.Equal(t, []*models.Property{
+			{Name: "key", DataType: []string{"uuid"}, IndexFilterable: &yes, IndexSearchable: &no},
+			{Name: "body", DataType: []string{"text"}, Tokenization: "field"},
+			{Name: "created", DataType: []string{"int"}, IndexRangeFilters: &yes},
+			{Name: "author", DataType: []string{"author"}},
+		}, class.Properties)
+		assert.Equal(t, models.VectorConfig{
+			Vectorizer:      map[string]interface{}{"text2vec-openai": map[string]interface{}{}},
+			VectorIndexType: "flat",
+		}, class.VectorConfig["body_vector"])
+	})
+
+	t.Run("references need a target", func(t *testing.T) {
+		type document struct {
+			Related []Reference `weaviate:"related,ref"`
+		}
+		_, err := Class[document]("Document")
+		assert.ErrorContains(t, err, "ref=Class")
+	})
+
+	t.Run("maps need a data type", func(t *testing.T) {
+		type document struct {
+			Meta map[string]string `weaviate:"meta"`
+		}
+		_, err := Class[document]("Document")
+		assert.ErrorContains(t, err, "dataType")
+	})
+
+	t.Run("structs nesting themselves", func(t *testing.T) {
+		type node struct {
+			Name     string `weaviate:"name"`
+			Children []node `weaviate:"children"`
+		}
+		_, err := Class[node]("Node")
+		assert.ErrorContains(t, err, "nests itself")
+
+		type tree struct {
+			Root *node `weaviate:"root"`
+		}
+		_, err = Class[tree]("Tree")
+		assert.ErrorContains(t, err, "nests itself")
+	})
+
+	t.Run("struct nested twice", func(t *testing.T) {
+		type address struct {
+			City string `weaviate:"city"`
+		}
+		type person struct {
+			Home address `weaviate:"home"`
+			Work address `weaviate:"work"`
+		}
+		class, err := Class[person]("Person")
+		require.NoError(t, err)
+		require.Len(t, class.Properties, 2)
+		assert.Equal(t, "city", class.Properties[1].NestedProperties[0].Name)
+	})
+}
@@ -0,0 +1,154 @@
+package typed
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type author struct {
+	ID   strfmt.UUID `weaviate:",id"`
+	Name string      `weaviate:"name"`
+}
+
+type section struct {
+	Heading string
+	Words   int `weaviate:"words"`
+}
+
+type article struct {
+	ID        strfmt.UUID           `weaviate:",id"`
+	Title     string                `weaviate:"title"`
+	WordCount int                   `weaviate:"wordCount"`
+	Score     float64               `weaviate:"score,omitempty"`
+	Published time.Time             `weaviate:"published"`
+	Tags      []string              `weaviate:"tags"`
+	Location  models.GeoCoordinates `weaviate:"location"`
+	Phone     *models.PhoneNumber   `weaviate:"phone"`
+	Sections  []section             `weaviate:"sections"`
+	Authors   []author              `weaviate:"hasAuthors,ref=Author"`
+	Related   *Reference            `weaviate:"related,ref=Article"`
+	Vector    []float32             `weaviate:",vector"`
+	Summary   []float32             `weaviate:"summary,vector"`
+	Internal  string                `weaviate:"-"`
+}
+
+func float32Ptr(f float32) *float32 {
+	return &f
+}
+
+func TestToObject(t *testing.T) {
+	published := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	object, err := ToObject("Article", &article{
+		ID:        "00000000-0000-0000-0000-000000000001",
+		Title:     "A",
+		WordCount: 10,
+		Published: published,
+		Tags:      []string{"x"},
+		Location:  models.GeoCoordinates{Latitude: float32Ptr(1.5), Longitude: float32Ptr(2)},
+		Phone:     &models.PhoneNumber{Input: "0123", DefaultCountry: "NL"},
+		Sections:  []section{{Heading: "Intro", Words: 3}},
+		Authors:   []author{{ID: "00000000-0000-0000-0000-00000000000a"}},
+		Related:   &Reference{Class: "Article", ID: "00000000-0000-0000-0000-000000000002"},
+		Vector:    []float32{1, 2},
+		Summary:   []float32{3},
+		Internal:  "ignored",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Article", object.Class)
+	assert.Equal(t, strfmt.UUID("00000000-0000-0000-0000-000000000001"), object.ID)
+	assert.Equal(t, models.C11yVector{1, 2}, object.Vector)
+	assert.Equal(t, models.Vectors{"summary": []float32{3}}, object.Vectors)
+	assert.Equal(t, map[string]interface{}{
+		"title":     "A",
+		"wordCount": int64(10),
+		"published": "2024-01-02T03:04:05Z",
+		"tags":      []string{"x"},
+		"location":  map[string]interface{}{"latitude": float32(1.5), "longitude": float32(2)},
+		"phone":     map[string]interface{}{"input": "0123", "defaultCountry": "NL"},
+		"sections":  []interface{}{map[string]interface{}{"heading": "Intro", "words": int64(3)}},
+		"hasAuthors": []map[string]interface{}{
+			{"beacon": "weaviate://localhost/Author/00000000-0000-0000-0000-00000000000a"},
+		},
+		"related": []map[string]interface{}{
+			{"beacon": "weaviate://localhost/Article/00000000-0000-0000-0000-000000000002"},
+		},
+	}, object.Properties)
+
+	t.Run("referenced objects need an id", func(t *testing.T) {
+		_, err := ToObject("Article", article{Authors: []author{{Name: "anonymous"}}})
+		assert.ErrorContains(t, err, "hasAuthors")
+	})
+
+	t.Run("only structs", func(t *testing.T) {
+		_, err := ToObject("Article", "A")
+		assert.Error(t, err)
+	})
+}
+
+func TestFromObject(t *testing.T) {
+	original := article{
+		ID:        "00000000-0000-0000-0000-000000000001",
+		Title:     "A",
+		WordCount: 10,
+		Score:     0.5,
+		Published: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Tags:      []string{"x", "y"},
+		Location:  models.GeoCoordinates{Latitude: float32Ptr(1.5), Longitude: float32Ptr(2)},
+		Phone:     &models.PhoneNumber{Input: "0123"},
+		Sections:  []section{{Heading: "Intro", Words: 3}},
+		Authors:   []author{{ID: "00000000-0000-0000-0000-00000000000a"}},
+		Related:   &Reference{Class: "Article", ID: "00000000-0000-0000-0000-000000000002"},
+		Vector:    []float32{1, 2},
+		Summary:   []float32{3},
+	}
+	object, err := ToObject("Article", original)
+	require.NoError(t, err)
+	// objects returned by the REST API are decoded from JSON
+	data, err := json.Marshal(object)
+	require.NoError(t, err)
+	var decoded models.Object
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	actual, err := FromObject[article](&decoded)
+	require.NoError(t, err)
+	assert.Equal(t, original, actual)
+
+	pointer, err := FromObject[*article](&decoded)
+	require.NoError(t, err)
+	assert.Equal(t, original, *pointer)
+
+	t.Run("type mismatch", func(t *testing.T) {
+		_, err := FromObject[article](&models.Object{Properties: map[string]interface{}{"wordCount": 1.5}})
+		assert.ErrorContains(t, err, "wordCount")
+	})
+}
+
+func TestDecode_GraphQL(t *testing.T) {
+	var result article
+	err := Decode(map[string]interface{}{
+		"title": "A",
+		"hasAuthors": []interface{}{
+			map[string]interface{}{
+				"name":        "Jane",
+				"_additional": map[string]interface{}{"id": "00000000-0000-0000-0000-00000000000a"},
+			},
+		},
+		"_additional": map[string]interface{}{
+			"id":      "00000000-0000-0000-0000-000000000001",
+			"vectors": map[string]interface{}{"summary": []interface{}{0.5}},
+		},
+	}, &result)
+	require.NoError(t, err)
+	assert.Equal(t, article{
+		ID:      "00000000-0000-0000-0000-000000000001",
+		Title:   "A",
+		Authors: []author{{ID: "00000000-0000-0000-0000-00000000000a", Name: "Jane"}},
+		Summary: []float32{0.5},
+	}, result)
+}
@@ -13,6 +13,13 @@ type MyUserGetter struct {
 }
 
 func (mug *MyUserGetter) Do(ctx context.Context) (UserInfo, error) {
+	ctx, op := mug.connection.Telemetry().Start(ctx, "users.MyUserGetter")
+	result, err := mug.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (mug *MyUserGetter) do(ctx context.Context) (UserInfo, error) {
 	path := "/users/own-info"
 	res, err := mug.connection.RunREST(ctx, path, http.MethodGet, nil)
 	if err != nil {
@@ -31,6 +31,13 @@ func (rr *RoleRevoker) WithRoles(roles ...string) *RoleRevoker {
 }
 
 func (rr *RoleRevoker) Do(ctx context.Context) error {
+	ctx, op := rr.connection.Telemetry().Start(ctx, "users.RoleRevoker")
+	err := rr.do(ctx)
+	op.End(err)
+	return err
+}
+
+func (rr *RoleRevoker) do(ctx context.Context) error {
 	payload := authz.RevokeRoleFromUserBody{
 		Roles:    rr.roles,
 		UserType: models.UserTypeInput(rr.userType),
@@ -22,6 +22,13 @@ func (r *UserDBActivator) WithUserID(id string) *UserDBActivator {
 }
 
 func (r *UserDBActivator) Do(ctx context.Context) (bool, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "users.UserDBActivator")
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *UserDBActivator) do(ctx context.Context) (bool, error) {
 	res, err := r.connection.RunREST(ctx, r.path(), http.MethodPost, nil)
 	if err != nil {
 		return false, except.NewDerivedWeaviateClientError(err)
@@ -22,6 +22,13 @@ func (r *UserDBCreator) WithUserID(id string) *UserDBCreator {
 }
 
 func (r *UserDBCreator) Do(ctx context.Context) (string, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "users.UserDBCreator")
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *UserDBCreator) do(ctx context.Context) (string, error) {
 	res, err := r.connection.RunREST(ctx, r.path(), http.MethodPost, nil)
 	if err != nil {
 		return "", except.NewDerivedWeaviateClientError(err)
@@ -28,6 +28,13 @@ func (r *UserDBDeactivator) WithRevokeKey(revokeKey bool) *UserDBDeactivator {
 }
 
 func (r *UserDBDeactivator) Do(ctx context.Context) (bool, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "users.UserDBDeactivator")
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *UserDBDeactivator) do(ctx context.Context) (bool, error) {
 	payload := struct {
 		RevokeKey bool `json:"revoke_key"`
 	}{
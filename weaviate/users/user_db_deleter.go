@@ -22,6 +22,13 @@ func (r *UserDBDeleter) WithUserID(id string) *UserDBDeleter {
 }
 
 func (r *UserDBDeleter) Do(ctx context.Context) (bool, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "users.UserDBDeleter")
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *UserDBDeleter) do(ctx context.Context) (bool, error) {
 	res, err := r.connection.RunREST(ctx, r.path(), http.MethodDelete, nil)
 	if err != nil {
 		return false, except.NewDerivedWeaviateClientError(err)
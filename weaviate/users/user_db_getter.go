@@ -28,6 +28,13 @@ func (r *UserDBGetter) WithLastUsedTime() *UserDBGetter {
 }
 
 func (r *UserDBGetter) Do(ctx context.Context) (UserInfo, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "users.UserDBGetter")
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *UserDBGetter) do(ctx context.Context) (UserInfo, error) {
 	res, err := r.connection.RunREST(ctx, r.path(), http.MethodGet, nil)
 	if err != nil {
 		return UserInfo{}, except.NewDerivedWeaviateClientError(err)
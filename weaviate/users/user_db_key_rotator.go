@@ -23,6 +23,13 @@ func (r *UserDBKeyRotator) WithUserID(id string) *UserDBKeyRotator {
 }
 
 func (r *UserDBKeyRotator) Do(ctx context.Context) (string, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "users.UserDBKeyRotator")
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *UserDBKeyRotator) do(ctx context.Context) (string, error) {
 	res, err := r.connection.RunREST(ctx, r.path(), http.MethodPost, nil)
 	if err != nil {
 		return "", except.NewDerivedWeaviateClientError(err)
@@ -31,6 +31,13 @@ func (r *UserDBLister) WithLastUsedTime() *UserDBLister {
 }
 
 func (r *UserDBLister) Do(ctx context.Context) (UserInfoList, error) {
+	ctx, op := r.connection.Telemetry().Start(ctx, "users.UserDBLister")
+	result, err := r.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (r *UserDBLister) do(ctx context.Context) (UserInfoList, error) {
 	res, err := r.connection.RunREST(ctx, r.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
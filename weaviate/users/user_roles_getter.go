@@ -30,6 +30,13 @@ func (urg *UserRolesGetter) WithIncludeFullRoles(include bool) *UserRolesGetter
 }
 
 func (urg *UserRolesGetter) Do(ctx context.Context) ([]*rbac.Role, error) {
+	ctx, op := urg.connection.Telemetry().Start(ctx, "users.UserRolesGetter")
+	result, err := urg.do(ctx)
+	op.End(err)
+	return result, err
+}
+
+func (urg *UserRolesGetter) do(ctx context.Context) ([]*rbac.Role, error) {
 	res, err := urg.connection.RunREST(ctx, urg.path(), http.MethodGet, nil)
 	if err != nil {
 		return nil, except.NewDerivedWeaviateClientError(err)
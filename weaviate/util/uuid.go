@@ -0,0 +1,232 @@
+// Package util holds helpers shared with the other Weaviate clients.
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+// GenerateUUID5 returns a UUIDv5 derived from identifier, which is the same
+// for equal identifiers, see GenerateUUID5WithNamespace.
+func GenerateUUID5(identifier any) strfmt.UUID {
+	return GenerateUUID5WithNamespace(identifier, "")
+}
+
+// GenerateUUID5WithNamespace returns a UUIDv5 derived from namespace and identifier
+// like generate_uuid5 of the Python client, in the DNS namespace over the text of
+// namespace followed by the text of identifier. Strings and integers give the same
+// UUIDs as the Python client, and times the same as aware datetimes. Maps and slices are
+// written like Python dicts and lists, with the keys of maps sorted, so they match Python
+// dicts with sorted keys.
+func GenerateUUID5WithNamespace(identifier, namespace any) strfmt.UUID {
+	name := pythonStr(namespace) + pythonStr(identifier)
+	return strfmt.UUID(uuid.NewSHA1(uuid.NameSpaceDNS, []byte(name)).String())
+}
+
+// GenerateUUID5FromProperties returns a UUIDv5 derived from the values of the given keys
+// of properties. A single key derives the UUID from its value, more than one key from
+// a map of the keys and their values, and no key from all properties.
+func GenerateUUID5FromProperties(properties map[string]any, keys ...string) strfmt.UUID {
+	switch len(keys) {
+	case 0:
+		return GenerateUUID5(properties)
+	case 1:
+		return GenerateUUID5(properties[keys[0]])
+	default:
+		selected := make(map[string]any, len(keys))
+		for _, key := range keys {
+			selected[key] = properties[key]
+		}
+		return GenerateUUID5(selected)
+	}
+}
+
+// pythonStr writes value like Python's str()
+func pythonStr(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case time.Time:
+		return datetime(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return pythonRepr(value)
+	}
+}
+
+// pythonRepr writes value like Python's repr()
+func pythonRepr(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "None"
+	case string:
+		return quote(v)
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case json.Number:
+		return v.String()
+	case float32:
+		return float(float64(v))
+	case float64:
+		return float(v)
+	case time.Time:
+		return datetimeRepr(v)
+	case fmt.Stringer:
+		return quote(v.String())
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Slice, reflect.Array:
+		items := make([]string, rv.Len())
+		for i := range items {
+			items[i] = pythonRepr(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case reflect.Map:
+		entries := make([]string, 0, rv.Len())
+		keys := rv.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+		for _, key := range keys {
+			entries = append(entries, pythonRepr(key.Interface())+": "+pythonRepr(rv.MapIndex(key).Interface()))
+		}
+		return "{" + strings.Join(entries, ", ") + "}"
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return "None"
+		}
+		return pythonRepr(rv.Elem().Interface())
+	case reflect.Struct:
+		// structs are written as the map of their JSON fields
+		var m map[string]any
+		if data, err := json.Marshal(value); err == nil && json.Unmarshal(data, &m) == nil {
+			return pythonRepr(m)
+		}
+	}
+	return fmt.Sprint(value)
+}
+
+// float writes f like Python's repr of a float
+func float(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "nan"
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	}
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-4 || abs >= 1e16) {
+		return strconv.FormatFloat(f, 'e', -1, 64)
+	}
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+// datetime writes t like Python's str of an aware datetime, which has microsecond precision
+func datetime(t time.Time) string {
+	s := t.Format("2006-01-02 15:04:05")
+	if microsecond := t.Nanosecond() / 1000; microsecond != 0 {
+		s += fmt.Sprintf(".%06d", microsecond)
+	}
+	_, offset := t.Zone()
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	s += fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf(":%02d", offset%60)
+	}
+	return s
+}
+
+// datetimeRepr writes t like Python's repr of an aware datetime
+func datetimeRepr(t time.Time) string {
+	fields := []int{t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond() / 1000}
+	// like Python, the microsecond and then the second are left out if they are 0
+	for range 2 {
+		if fields[len(fields)-1] == 0 {
+			fields = fields[:len(fields)-1]
+		}
+	}
+	args := make([]string, len(fields))
+	for i, field := range fields {
+		args[i] = strconv.Itoa(field)
+	}
+	tz := "datetime.timezone.utc"
+	if _, offset := t.Zone(); offset != 0 {
+		// timedeltas are normalized to a positive number of seconds within a day
+		days, seconds := offset/86400, offset%86400
+		if seconds < 0 {
+			days, seconds = days-1, seconds+86400
+		}
+		var delta []string
+		if days != 0 {
+			delta = append(delta, "days="+strconv.Itoa(days))
+		}
+		if seconds != 0 {
+			delta = append(delta, "seconds="+strconv.Itoa(seconds))
+		}
+		tz = "datetime.timezone(datetime.timedelta(" + strings.Join(delta, ", ") + "))"
+	}
+	return "datetime.datetime(" + strings.Join(args, ", ") + ", tzinfo=" + tz + ")"
+}
+
+// quote writes s like Python's repr of a string
+func quote(s string) string {
+	q := '\''
+	if strings.ContainsRune(s, '\'') && !strings.ContainsRune(s, '"') {
+		q = '"'
+	}
+	var b strings.Builder
+	b.WriteRune(q)
+	for _, r := range s {
+		switch {
+		case r == q || r == '\\':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == utf8.RuneError || unicode.IsPrint(r):
+			b.WriteRune(r)
+		case r < 0x100:
+			fmt.Fprintf(&b, `\x%02x`, r)
+		case r < 0x10000:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			fmt.Fprintf(&b, `\U%08x`, r)
+		}
+	}
+	b.WriteRune(q)
+	return b.String()
+}
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+// the expected UUIDs are generated with generate_uuid5 of the Python client
+func TestGenerateUUID5(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier any
+		namespace  any
+		expected   strfmt.UUID
+	}{
+		{name: "string", identifier: "hello", expected: "9342d47a-1bab-5709-9869-c840b2eac501"},
+		{name: "integer", identifier: 42, expected: "7c411b5e-9d3f-50b5-9c28-62096e41c4ed"},
+		{name: "namespace", identifier: "hello", namespace: "ns", expected: "9626990f-66db-5fe1-981a-516aa9553daa"},
+		{
+			name: "map",
+			identifier: map[string]any{
+				"title": "A", "count": 1, "tags": []string{"x", "it's"}, "score": 1.0, "ok": true, "none": nil,
+			},
+			expected: "304c77d7-d022-5712-91c3-e464e08c32bf",
+		},
+		{name: "floats", identifier: map[string]float64{"a": 1e-5, "b": 1e16, "c": 0.1}, expected: "504af264-026a-5346-9504-c26c4f882555"},
+		{name: "datetime", identifier: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), expected: "e8d113fc-2a7e-5ccd-aac5-db00072775df"},
+		{
+			name:       "datetime with offset",
+			identifier: time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.FixedZone("", -(5*3600+30*60))),
+			expected:   "04617546-3d7d-5d93-8427-14d48afafc07",
+		},
+		{
+			name: "datetimes in a map",
+			identifier: map[string]any{
+				"at": time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.FixedZone("", -(5*3600+30*60))),
+				"n":  time.Date(2024, 1, 2, 3, 4, 0, 0, time.FixedZone("", 3600)),
+			},
+			expected: "a4ed3e90-12a3-5b26-b6c2-41c3c18efccb",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespace := tt.namespace
+			if namespace == nil {
+				namespace = ""
+			}
+			assert.Equal(t, tt.expected, GenerateUUID5WithNamespace(tt.identifier, namespace))
+		})
+	}
+}
+
+func TestGenerateUUID5FromProperties(t *testing.T) {
+	properties := map[string]any{"title": "hello", "count": 1}
+	assert.Equal(t, GenerateUUID5("hello"), GenerateUUID5FromProperties(properties, "title"))
+	assert.Equal(t, GenerateUUID5(properties), GenerateUUID5FromProperties(properties))
+	assert.Equal(t, GenerateUUID5(properties), GenerateUUID5FromProperties(properties, "title", "count"))
+	assert.NotEqual(t, GenerateUUID5(properties), GenerateUUID5FromProperties(map[string]any{"title": "hello", "count": 2}))
+}
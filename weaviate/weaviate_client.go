@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,9 +24,12 @@ import (
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/groups"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/grpc"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/internal"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/loadbalance"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/misc"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/rbac"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/retry"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/schema"
+	"github.com/weaviate/weaviate-go-client/v5/weaviate/telemetry"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/tokenize"
 	"github.com/weaviate/weaviate-go-client/v5/weaviate/users"
 )
@@ -37,6 +42,13 @@ type Config struct {
 	Host string
 	// Scheme of the weaviate instance; this is a mandatory field.
 	Scheme string
+	// Hosts of further Weaviate nodes, REST requests are spread across Host and Hosts
+	// according to LoadBalancing.
+	Hosts []string
+
+	// Load balancing across the nodes of the cluster. If omitted, the defaults are used
+	// when Hosts are given, otherwise all requests go to Host.
+	LoadBalancing *loadbalance.Config
 
 	// ConnectionClient that will be used to execute http requests to the weaviate instance.
 	//  If omitted a default will be used. The default is not able to handle authenticated requests.
@@ -59,6 +71,23 @@ type Config struct {
 
 	// Client connection timeout, defaults to 60s
 	Timeout time.Duration
+
+	// Retry policy applied to REST requests. If omitted, requests are not retried.
+	RetryConfig *retry.Config
+
+	// Interceptors wrapping every REST request, e.g. to add request IDs or audit logging.
+	// The first interceptor is the outermost one.
+	RESTInterceptors []connection.RESTInterceptor
+
+	// Unary interceptors applied to every gRPC call, after the ones used by the client itself.
+	GrpcInterceptors []connection.GrpcInterceptor
+
+	// OpenTelemetry tracing and metrics configuration. If omitted, the client is not instrumented.
+	Telemetry *telemetry.Config
+
+	// Logger used by every part of the client, defaults to slog.Default().
+	// Requests and responses are logged at debug level with credentials redacted.
+	Logger *slog.Logger
 }
 
 func (c Config) getTimeout() time.Duration {
@@ -132,6 +161,11 @@ func (e *experimental) Search() *graphql.Search {
 	return graphql.NewSearch(e.grpcClient)
 }
 
+// Experimental Aggregate gRPC API group
+func (e *experimental) Aggregate() *graphql.Aggregate {
+	return graphql.NewAggregate(e.grpcClient)
+}
+
 func NewClient(config Config) (*Client, error) {
 	if config.AuthConfig != nil && config.ConnectionClient != nil {
 		return nil, errors.New("only AuthConfig or ConnectionClient can be given in the config")
@@ -140,7 +174,8 @@ func NewClient(config Config) (*Client, error) {
 	// if an authentication config is given, we first need to create a temporary connection to fetch some OIDC
 	// infos from Weaviate. This connection is then replaced by the "real" connection
 	if config.AuthConfig != nil {
-		tmpCon := connection.NewConnection(config.Scheme, config.Host, nil, config.getTimeout(), config.Headers)
+		tmpCon := connection.NewConnection(config.Scheme, config.Host, nil, config.getTimeout(), config.Headers).
+			WithLogger(config.Logger)
 		err := tmpCon.WaitForWeaviate(config.StartupTimeout)
 		if err != nil {
 			return nil, err
@@ -163,11 +198,21 @@ func NewClient(config Config) (*Client, error) {
 		config.Headers["X-Weaviate-Client"] = internal.GetClientVersionHeader()
 	}
 
-	con := connection.NewConnection(config.Scheme, config.Host, config.ConnectionClient, config.getTimeout(), config.Headers)
+	tel, err := telemetry.New(config.Telemetry)
+	if err != nil {
+		return nil, fmt.Errorf("create weaviate client: %w", err)
+	}
+
+	con := newConnection(config, tel)
 
 	if err := con.WaitForWeaviate(config.StartupTimeout); err != nil {
+		con.Close()
 		return nil, err
 	}
+	if err := discoverNodes(&config, con); err != nil {
+		con.Close()
+		return nil, fmt.Errorf("create weaviate client: %w", err)
+	}
 
 	// some endpoints now require a className namespace.
 	// to determine if this new convention is to be used,
@@ -181,11 +226,12 @@ func NewClient(config Config) (*Client, error) {
 	}
 
 	dbVersionProvider := db.NewVersionProvider(getVersionFn)
-	dbVersionSupport := db.NewDBVersionSupport(dbVersionProvider)
+	dbVersionSupport := db.NewDBVersionSupport(dbVersionProvider).WithLogger(config.Logger)
 	grpcVersionSupport := db.NewGRPCVersionSupport(dbVersionProvider)
 
-	grpcClient, err := createGrpcClient(config, grpcVersionSupport)
+	grpcClient, err := createGrpcClient(config, grpcVersionSupport, tel)
 	if err != nil {
+		con.Close()
 		return nil, fmt.Errorf("create weaviate client: %w", err)
 	}
 
@@ -218,7 +264,17 @@ func New(config Config) *Client {
 	if client, err := NewClient(config); err == nil {
 		return client
 	}
-	con := connection.NewConnection(config.Scheme, config.Host, config.ConnectionClient, config.getTimeout(), config.Headers)
+	tel, err := telemetry.New(config.Telemetry)
+	if err != nil {
+		// the client works without instrumentation, so this is not worth a panic
+		logger := config.Logger
+		if logger == nil {
+			logger = slog.Default()
+		}
+		logger.Warn("failed to create telemetry, continuing without it", "error", err)
+		tel = nil
+	}
+	con := newConnection(config, tel)
 
 	// some endpoints now require a className namespace.
 	// to determine if this new convention is to be used,
@@ -232,11 +288,12 @@ func New(config Config) *Client {
 	}
 
 	dbVersionProvider := db.NewVersionProvider(getVersionFn)
-	dbVersionSupport := db.NewDBVersionSupport(dbVersionProvider)
+	dbVersionSupport := db.NewDBVersionSupport(dbVersionProvider).WithLogger(config.Logger)
 	gRPCVersionSupport := db.NewGRPCVersionSupport(dbVersionProvider)
 
-	grpcClient, err := createGrpcClient(config, gRPCVersionSupport)
+	grpcClient, err := createGrpcClient(config, gRPCVersionSupport, tel)
 	if err != nil {
+		con.Close()
 		panic(err)
 	}
 
@@ -273,6 +330,17 @@ func (c *Client) WaitForWeaviate(startupTimeout time.Duration) error {
 	return c.connection.WaitForWeaviate(startupTimeout)
 }
 
+// Close the client: it waits for in-flight requests to finish, closes the gRPC
+// connection and stops the token refresh goroutine. Requests made after Close
+// return fault.ErrClientClosed. Calling Close more than once is a no-op.
+func (c *Client) Close() error {
+	var grpcErr error
+	if c.grpcClient != nil {
+		grpcErr = c.grpcClient.Close()
+	}
+	return errors.Join(grpcErr, c.connection.Close())
+}
+
 // Misc collection group for .well_known and root level API commands
 func (c *Client) Misc() *misc.API {
 	return c.misc
@@ -345,14 +413,72 @@ func (c *Client) Experimental() *experimental {
 	return c.experimental
 }
 
-func createGrpcClient(config Config, gRPCVersionSupport *db.GRPCVersionSupport) (*connection.GrpcClient, error) {
+// newConnection creates the connection of the client. The logger is passed in on creation,
+// so that the token refresh started with the connection logs to it from the start.
+func newConnection(config Config, tel *telemetry.Telemetry) *connection.Connection {
+	return connection.NewConnectionWithOptions(connection.ConnectionOptions{
+		Scheme:     config.Scheme,
+		Host:       config.Host,
+		HTTPClient: config.ConnectionClient,
+		Timeout:    config.getTimeout(),
+		Headers:    config.Headers,
+		Logger:     config.Logger,
+	}).
+		WithRetry(config.RetryConfig).
+		WithInterceptors(config.RESTInterceptors...).
+		WithTelemetry(tel).
+		WithLoadBalancing(config.LoadBalancing, config.Hosts...)
+}
+
+func createGrpcClient(config Config, gRPCVersionSupport *db.GRPCVersionSupport, tel *telemetry.Telemetry) (*connection.GrpcClient, error) {
 	if config.GrpcConfig != nil {
-		return connection.NewGrpcClient(config.GrpcConfig.Host, config.GrpcConfig.Secured, config.Headers, gRPCVersionSupport, config.getTimeout(), config.StartupTimeout,
-			config.GrpcConfig.Keepalive)
+		grpcClient, err := connection.NewGrpcClientWithOptions(connection.GrpcOptions{
+			Config:             config.GrpcConfig,
+			Headers:            config.Headers,
+			GRPCVersionSupport: gRPCVersionSupport,
+			Timeout:            config.getTimeout(),
+			StartupTimeout:     config.StartupTimeout,
+			LoadBalancing:      config.LoadBalancing,
+			Interceptors:       config.GrpcInterceptors,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return grpcClient.WithTelemetry(tel).WithLogger(config.Logger), nil
 	}
 	return nil, nil
 }
 
+// discoverNodes adds the nodes of the cluster returned by the nodes API to the REST connection
+// and to the gRPC config, if node discovery is enabled.
+func discoverNodes(config *Config, con *connection.Connection) error {
+	if config.LoadBalancing == nil || config.LoadBalancing.DiscoverNodes == nil {
+		return nil
+	}
+	nodesStatus, err := cluster.New(con).NodesStatusGetter().Do(context.Background())
+	if err != nil {
+		return fmt.Errorf("discover nodes: %w", err)
+	}
+	var grpcConfig grpc.Config
+	if config.GrpcConfig != nil {
+		grpcConfig = *config.GrpcConfig
+		grpcConfig.Hosts = slices.Clone(grpcConfig.Hosts)
+	}
+	for _, node := range nodesStatus.Nodes {
+		host, grpcHost := config.LoadBalancing.DiscoverNodes(node)
+		if host != "" {
+			con.AddHosts(host)
+		}
+		if grpcHost != "" && grpcHost != grpcConfig.Host && !slices.Contains(grpcConfig.Hosts, grpcHost) {
+			grpcConfig.Hosts = append(grpcConfig.Hosts, grpcHost)
+		}
+	}
+	if config.GrpcConfig != nil {
+		config.GrpcConfig = &grpcConfig
+	}
+	return nil
+}
+
 func isWeaviateDomain(url string) bool {
 	lower := strings.ToLower(url)
 	return strings.Contains(lower, "weaviate.io") || strings.Contains(lower, "semi.technology") || strings.Contains(lower, "weaviate.cloud")